@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/mattfarina/semver-isvalid/pkg/semver"
+)
+
+// checkBaseline reads the version in the file passed to --baseline-file and
+// checks that the already validated version is newer by precedence. The
+// returned exit code is non-zero if the baseline cannot be read, is invalid,
+// or the version is not newer.
+func checkBaseline(out, errOut io.Writer, ver string) int {
+	b, err := ioutil.ReadFile(baselineFile)
+	if err != nil {
+		red.Fprintf(errOut, "Unable to read baseline version: %s\n", err)
+		return 7
+	}
+
+	ver, base := stripV(ver), stripV(strings.TrimSpace(string(b)))
+	if err, _ := semver.Validate(base); err != nil {
+		red.Fprintf(errOut, "Invalid baseline version %q in %s: %s\n", base, baselineFile, err)
+		return exitCode(err)
+	}
+
+	c, err := semver.Compare(ver, base)
+	if err != nil {
+		red.Fprintf(errOut, "Unable to compare version %s to the baseline version %s: %s\n", ver, base, err)
+		return exitCode(err)
+	}
+	if c <= 0 {
+		red.Fprintf(errOut, "Version %s is not newer than the baseline version %s in %s\n", ver, base, baselineFile)
+		return 13
+	}
+
+	fmt.Fprintf(out, "Version is newer than the baseline version %s\n", base)
+	return 0
+}
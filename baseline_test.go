@@ -0,0 +1,83 @@
+package main
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidateBaseline(t *testing.T) {
+	root := writeFiles(t, map[string]string{
+		"BASELINE":   "1.2.3\n",
+		"V_BASELINE": "v1.2.3\n",
+		"INVALID":    "1.2\n",
+	})
+
+	tests := []struct {
+		version, file string
+		withV         bool
+		code          int
+		message       string
+	}{
+		{"1.2.4", "BASELINE", false, 0, "Version is newer than the baseline version 1.2.3"},
+		{"2.0.0-rc.1", "BASELINE", false, 0, "newer"},
+		{"1.2.3", "BASELINE", false, 13, "Version 1.2.3 is not newer than the baseline version 1.2.3"},
+		{"1.2.3+build", "BASELINE", false, 13, "not newer"},
+		{"1.2.2", "BASELINE", false, 13, "not newer"},
+		{"1.2.4-rc.1", "BASELINE", false, 0, "newer"},
+		{"v1.2.4", "V_BASELINE", true, 0, "newer"},
+		{"v1.2.3", "V_BASELINE", true, 13, "not newer"},
+		{"1.2.4", "V_BASELINE", false, 5, `Invalid baseline version "v1.2.3"`},
+		{"1.2.4", "INVALID", false, 4, `Invalid baseline version "1.2"`},
+		{"1.2.4", "MISSING", false, 7, "Unable to read baseline version"},
+	}
+
+	defer func() { baselineFile, withV = "", false }()
+	for _, tc := range tests {
+		baselineFile, withV = filepath.Join(root, tc.file), tc.withV
+
+		var out, errOut bytes.Buffer
+		if code := validate(&out, &errOut, tc.version); code != tc.code {
+			t.Errorf("expected exit code %d for %s and %s but got %d", tc.code, tc.version, tc.file, code)
+		}
+
+		if o := out.String() + errOut.String(); !strings.Contains(o, tc.message) {
+			t.Errorf("expected %q in output for %s and %s:\n%s", tc.message, tc.version, tc.file, o)
+		}
+	}
+}
+
+func TestBaselineFlagErrors(t *testing.T) {
+	tests := []struct {
+		baseline, dir, file, input, output string
+		message                            string
+	}{
+		{"VERSION", "", "", "", "text", ""},
+		{"VERSION", ".", "", "", "text", "The --baseline-file flag cannot be used with --dir"},
+		{"VERSION", "", "-", "", "text", "The --baseline-file flag cannot be used with --file"},
+		{"VERSION", "", "", "json", "text", "The --baseline-file flag cannot be used with --input"},
+		{"VERSION", "", "", "", "shields", "The --baseline-file flag cannot be used with --output shields"},
+	}
+
+	defer func() {
+		baselineFile, dir, file, input, output = "", "", "", "", "text"
+	}()
+	for _, tc := range tests {
+		baselineFile, dir, file, input, output = tc.baseline, tc.dir, tc.file, tc.input, tc.output
+		if msg := flagError(); msg != tc.message {
+			t.Errorf("expected %q for %+v but got %q", tc.message, tc, msg)
+		}
+	}
+}
+
+func TestBaselineSatisfies(t *testing.T) {
+	cmd := newRootCmd()
+	cmd.SetArgs([]string{"satisfies", "1.2.3", "^1.0.0", "--baseline-file", "VERSION"})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "unknown flag") {
+		t.Errorf("expected unknown flag error for --baseline-file but got %v", err)
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// validateDir walks the directory tree looking for version files whose names
+// match the glob. The version in each file is validated and reported. The
+// returned exit code is non-zero if the glob is invalid, the files cannot be
+// read, or any version is invalid.
+func validateDir(out, errOut io.Writer, root, glob string) int {
+	if _, err := filepath.Match(glob, ""); err != nil {
+		red.Fprintf(errOut, "Invalid version file glob %q: %s\n", glob, err)
+		return 7
+	}
+
+	var valid, invalid int
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			if info.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if match, _ := filepath.Match(glob, info.Name()); !match {
+			return nil
+		}
+
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		if _, err := check(strings.TrimSpace(string(b))); err != nil {
+			invalid++
+			red.Fprintf(out, "%s: Invalid Semantic Version: %s\n", path, err)
+		} else {
+			valid++
+			fmt.Fprintf(out, "%s: Semantic Version is valid\n", path)
+		}
+
+		return nil
+	})
+	if err != nil {
+		red.Fprintf(errOut, "Unable to read version files: %s\n", err)
+		return 7
+	}
+
+	fmt.Fprintf(out, "Found %d valid and %d invalid versions\n", valid, invalid)
+	if invalid > 0 {
+		return 8
+	}
+
+	return 0
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	root := t.TempDir()
+	for name, content := range files {
+		p := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return root
+}
+
+func TestValidateDir(t *testing.T) {
+	root := writeFiles(t, map[string]string{
+		"VERSION":              "1.2.3\n",
+		"packages/foo/VERSION": "1.2\n",
+		"packages/bar/VERSION": "v2.0.0\n",
+		"packages/baz/VERSION": "0.1.0-beta.1",
+		"packages/baz/README":  "not a version",
+		".git/VERSION":         "not a version",
+	})
+
+	tests := []struct {
+		withV   bool
+		code    int
+		valid   []string
+		invalid []string
+		summary string
+	}{
+		{
+			false, 8,
+			[]string{"VERSION", "packages/baz/VERSION"},
+			[]string{"packages/foo/VERSION", "packages/bar/VERSION"},
+			"Found 2 valid and 2 invalid versions",
+		},
+		{
+			true, 8,
+			[]string{"VERSION", "packages/baz/VERSION", "packages/bar/VERSION"},
+			[]string{"packages/foo/VERSION"},
+			"Found 3 valid and 1 invalid versions",
+		},
+	}
+
+	defer func() { withV = false }()
+	for _, tc := range tests {
+		withV = tc.withV
+		var out, errOut bytes.Buffer
+		code := validateDir(&out, &errOut, root, "VERSION")
+		if code != tc.code {
+			t.Errorf("expected exit code %d but got %d", tc.code, code)
+		}
+
+		o := out.String()
+		for _, f := range tc.valid {
+			p := filepath.Join(root, filepath.FromSlash(f))
+			if !strings.Contains(o, p+": Semantic Version is valid") {
+				t.Errorf("expected %s to be valid in output:\n%s", f, o)
+			}
+		}
+		for _, f := range tc.invalid {
+			p := filepath.Join(root, filepath.FromSlash(f))
+			if !strings.Contains(o, p+": Invalid Semantic Version") {
+				t.Errorf("expected %s to be invalid in output:\n%s", f, o)
+			}
+		}
+		if !strings.Contains(o, tc.summary) {
+			t.Errorf("expected summary %q in output:\n%s", tc.summary, o)
+		}
+	}
+}
+
+func TestValidateDirGlob(t *testing.T) {
+	root := writeFiles(t, map[string]string{
+		"a/app.version": "1.2.3",
+		"b/lib.version": "1.0.0",
+		"c/VERSION":     "bad",
+	})
+
+	var out, errOut bytes.Buffer
+	if code := validateDir(&out, &errOut, root, "*.version"); code != 0 {
+		t.Errorf("expected exit code 0 but got %d:\n%s", code, out.String())
+	}
+	if !strings.Contains(out.String(), "Found 2 valid and 0 invalid versions") {
+		t.Errorf("unexpected output:\n%s", out.String())
+	}
+
+	out.Reset()
+	if code := validateDir(&out, &errOut, filepath.Join(root, "missing"), "VERSION"); code != 7 {
+		t.Errorf("expected exit code 7 but got %d", code)
+	}
+	if !strings.Contains(errOut.String(), "Unable to read version files") {
+		t.Errorf("expected read error on stderr but got %q", errOut.String())
+	}
+
+	out.Reset()
+	errOut.Reset()
+	if code := validateDir(&out, &errOut, root, "[VERSION"); code != 7 {
+		t.Errorf("expected exit code 7 but got %d", code)
+	}
+	if out.Len() != 0 || !strings.Contains(errOut.String(), `Invalid version file glob "[VERSION"`) {
+		t.Errorf("expected glob error on stderr only but got stdout %q and stderr %q", out.String(), errOut.String())
+	}
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/mattfarina/semver-isvalid/pkg/semver"
+)
+
+// checkExpected compares the already validated version to the one passed to
+// --expect by precedence. Build metadata is only compared when
+// --strict-metadata is used. The returned exit code is non-zero if the
+// expected version is invalid or the versions do not match.
+func checkExpected(out, errOut io.Writer, ver string) int {
+	ver, exp := stripV(ver), stripV(expect)
+	if err, _ := semver.Validate(exp); err != nil {
+		red.Fprintf(errOut, "Invalid expected version %q: %s\n", expect, err)
+		return exitCode(err)
+	}
+
+	c, err := semver.Compare(ver, exp)
+	if err != nil {
+		red.Fprintf(errOut, "Unable to compare version %s to the expected version %s: %s\n", ver, exp, err)
+		return exitCode(err)
+	}
+	if c != 0 {
+		red.Fprintf(errOut, "Version %s does not match the expected version %s\n", ver, exp)
+		return 12
+	}
+
+	if strictMetadata {
+		// Both versions parse as they were compared above
+		v, _ := semver.Parse(ver)
+		e, _ := semver.Parse(exp)
+		if v.Metadata() != e.Metadata() {
+			red.Fprintf(errOut, "Version %s does not match the expected version %s. The build metadata %q differs from %q\n", ver, exp, v.Metadata(), e.Metadata())
+			return 12
+		}
+	}
+
+	fmt.Fprintf(out, "Version matches the expected version %s\n", exp)
+	return 0
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestValidateExpect(t *testing.T) {
+	tests := []struct {
+		version, expected string
+		strict            bool
+		code              int
+		message           string
+	}{
+		{"1.2.3", "1.2.3", false, 0, "Version matches the expected version 1.2.3"},
+		{"1.2.3", "1.2.4", false, 12, "Version 1.2.3 does not match the expected version 1.2.4"},
+		{"1.2.3-rc.1", "1.2.3", false, 12, "does not match"},
+		{"1.2.3+a", "1.2.3+b", false, 0, "Version matches the expected version 1.2.3+b"},
+		{"1.2.3+a", "1.2.3+b", true, 12, `The build metadata "a" differs from "b"`},
+		{"1.2.3+a", "1.2.3+a", true, 0, "Version matches"},
+		{"1.2.3", "1.2", false, 4, `Invalid expected version "1.2"`},
+		{"1.2", "1.2.3", false, 4, "Invalid Semantic Version"},
+	}
+
+	defer func() { expect, strictMetadata = "", false }()
+	for _, tc := range tests {
+		expect, strictMetadata = tc.expected, tc.strict
+
+		var out, errOut bytes.Buffer
+		if code := validate(&out, &errOut, tc.version); code != tc.code {
+			t.Errorf("expected exit code %d for %s and %s but got %d", tc.code, tc.version, tc.expected, code)
+		}
+
+		if o := out.String() + errOut.String(); !strings.Contains(o, tc.message) {
+			t.Errorf("expected %q in output for %s and %s:\n%s", tc.message, tc.version, tc.expected, o)
+		}
+	}
+}
+
+func TestExpectFlagErrors(t *testing.T) {
+	tests := []struct {
+		expect, dir, file, input, output string
+		strict                           bool
+		message                          string
+	}{
+		{"1.2.3", "", "", "", "text", false, ""},
+		{"1.2.3", "", "", "", "text", true, ""},
+		{"", "", "", "", "text", true, "The --strict-metadata flag can only be used with --expect"},
+		{"1.2.3", ".", "", "", "text", false, "The --expect flag cannot be used with --dir"},
+		{"1.2.3", "", "-", "", "text", false, "The --expect flag cannot be used with --file"},
+		{"1.2.3", "", "", "json", "text", false, "The --expect flag cannot be used with --input"},
+		{"1.2.3", "", "", "", "shields", false, "The --expect flag cannot be used with --output shields"},
+		{"", ".", "", "", "text", false, ""},
+	}
+
+	defer func() {
+		expect, dir, file, input, output, strictMetadata = "", "", "", "", "text", false
+	}()
+	for _, tc := range tests {
+		expect, dir, file, input, output, strictMetadata = tc.expect, tc.dir, tc.file, tc.input, tc.output, tc.strict
+		if msg := flagError(); msg != tc.message {
+			t.Errorf("expected %q for %+v but got %q", tc.message, tc, msg)
+		}
+	}
+}
+
+func TestExpectSatisfies(t *testing.T) {
+	for _, f := range []string{"--expect=9.9.9", "--strict-metadata"} {
+		cmd := newRootCmd()
+		cmd.SetArgs([]string{"satisfies", "1.2.3", "^1.0.0", f})
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetErr(&bytes.Buffer{})
+
+		if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "unknown flag") {
+			t.Errorf("expected unknown flag error for %s but got %v", f, err)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// result is the outcome of validating a single version when the output is
+// JSON.
+type result struct {
+	Version  string   `json:"version"`
+	Valid    bool     `json:"valid"`
+	Error    string   `json:"error,omitempty"`
+	Messages []string `json:"messages"`
+}
+
+// validateJSON reads a JSON array of versions from in and writes a JSON array
+// with the result for each version to out. The returned exit code is non-zero
+// if the input is malformed or any version is invalid.
+func validateJSON(in io.Reader, out, errOut io.Writer) int {
+	var versions []string
+	dec := json.NewDecoder(in)
+	if err := dec.Decode(&versions); err != nil {
+		red.Fprintf(errOut, "Unable to parse input as a JSON array of versions: %s\n", err)
+		return 9
+	}
+
+	// A null decodes to a nil slice rather than an array
+	if versions == nil {
+		red.Fprint(errOut, "Unable to parse input as a JSON array of versions: found null\n")
+		return 9
+	}
+
+	// Only whitespace may follow the array
+	if _, err := dec.Token(); err != io.EOF {
+		red.Fprint(errOut, "Unable to parse input as a JSON array of versions: found data after the array\n")
+		return 9
+	}
+
+	code := 0
+	results := make([]result, 0, len(versions))
+	for _, ver := range versions {
+		msgs, err := check(ver)
+		r := result{
+			Version:  ver,
+			Valid:    err == nil,
+			Messages: msgs,
+		}
+		if r.Messages == nil {
+			r.Messages = []string{}
+		}
+		if err != nil {
+			r.Error = err.Error()
+			code = 8
+		}
+		results = append(results, r)
+	}
+
+	if err := json.NewEncoder(out).Encode(results); err != nil {
+		red.Fprintf(errOut, "Unable to write results: %s\n", err)
+		return 2
+	}
+
+	return code
+}
+
+// validateFile validates the versions in a file, one per line. A path of -
+// reads the versions from stdin. The returned exit code is non-zero if the
+// file cannot be read or any version is invalid.
+func validateFile(out, errOut io.Writer, in io.Reader, path string) int {
+	name := path
+	if path == "-" {
+		name = "stdin"
+	} else {
+		f, err := os.Open(path)
+		if err != nil {
+			red.Fprintf(errOut, "Unable to read versions: %s\n", err)
+			return 7
+		}
+		defer f.Close()
+		in = f
+	}
+
+	return validateLines(out, errOut, in, name)
+}
+
+// validateLines validates each line read from in as a version. Lines that are
+// empty or only whitespace, such as a trailing blank line, are skipped and not
+// counted as versions. This is different from validating a single version
+// where an empty version is an error.
+func validateLines(out, errOut io.Writer, in io.Reader, name string) int {
+	var valid, invalid int
+	scanner := bufio.NewScanner(in)
+	for line := 1; scanner.Scan(); line++ {
+		ver := strings.TrimSpace(scanner.Text())
+		if ver == "" {
+			continue
+		}
+
+		if _, err := check(ver); err != nil {
+			invalid++
+			red.Fprintf(out, "%s:%d: %s: Invalid Semantic Version: %s\n", name, line, ver, err)
+		} else {
+			valid++
+			fmt.Fprintf(out, "%s:%d: %s: Semantic Version is valid\n", name, line, ver)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		red.Fprintf(errOut, "Unable to read versions: %s\n", err)
+		return 7
+	}
+
+	fmt.Fprintf(out, "Found %d valid and %d invalid versions\n", valid, invalid)
+	if invalid > 0 {
+		return 8
+	}
+
+	return 0
+}
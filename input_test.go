@@ -0,0 +1,96 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidateJSON(t *testing.T) {
+	var out, errOut bytes.Buffer
+	code := validateJSON(strings.NewReader(`["1.2.3", "1.2"]`), &out, &errOut)
+	if code != 8 {
+		t.Errorf("expected exit code 8 but got %d", code)
+	}
+
+	var results []result
+	if err := json.Unmarshal(out.Bytes(), &results); err != nil {
+		t.Fatalf("unable to parse output %q: %s", out.String(), err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results but got %d", len(results))
+	}
+
+	if results[0].Version != "1.2.3" || !results[0].Valid || results[0].Error != "" {
+		t.Errorf("unexpected result for valid version: %+v", results[0])
+	}
+	if results[1].Version != "1.2" || results[1].Valid || results[1].Error != "Version does not have 3 parts" {
+		t.Errorf("unexpected result for invalid version: %+v", results[1])
+	}
+}
+
+func TestValidateJSONEmpty(t *testing.T) {
+	var out, errOut bytes.Buffer
+	if code := validateJSON(strings.NewReader(" [] \n"), &out, &errOut); code != 0 {
+		t.Errorf("expected exit code 0 but got %d", code)
+	}
+	if strings.TrimSpace(out.String()) != "[]" {
+		t.Errorf("expected an empty array but got %q", out.String())
+	}
+}
+
+func TestValidateJSONMalformed(t *testing.T) {
+	for _, in := range []string{`["1.2.3"`, `{"version": "1.2.3"}`, `[1, 2]`, ``, `["1.2.3"] garbage`, `["1.2.3"] ["1.2.4"]`, `null`} {
+		var out, errOut bytes.Buffer
+		if code := validateJSON(strings.NewReader(in), &out, &errOut); code != 9 {
+			t.Errorf("expected exit code 9 for %q but got %d", in, code)
+		}
+		if !strings.Contains(errOut.String(), "Unable to parse input") {
+			t.Errorf("expected an error message for %q but got %q", in, errOut.String())
+		}
+	}
+}
+
+func TestValidateFile(t *testing.T) {
+	root := writeFiles(t, map[string]string{
+		"versions.txt": "1.2.3\n\n1.2\n  \t\n2.0.0-rc.1\r\n\n",
+		"valid.txt":    "1.2.3\n1.3.0\n\n",
+	})
+
+	var out, errOut bytes.Buffer
+	if code := validateFile(&out, &errOut, nil, filepath.Join(root, "versions.txt")); code != 8 {
+		t.Errorf("expected exit code 8 but got %d", code)
+	}
+	if !strings.Contains(out.String(), "Found 2 valid and 1 invalid versions") {
+		t.Errorf("unexpected output:\n%s", out.String())
+	}
+	if !strings.Contains(out.String(), ":3: 1.2: Invalid Semantic Version") {
+		t.Errorf("expected the line of the invalid version in output:\n%s", out.String())
+	}
+	if strings.Contains(out.String(), "Version string empty") {
+		t.Errorf("expected blank lines to be skipped:\n%s", out.String())
+	}
+
+	out.Reset()
+	if code := validateFile(&out, &errOut, nil, filepath.Join(root, "valid.txt")); code != 0 {
+		t.Errorf("expected exit code 0 but got %d:\n%s", code, out.String())
+	}
+
+	out.Reset()
+	if code := validateFile(&out, &errOut, strings.NewReader("1.2.3\n\n"), "-"); code != 0 {
+		t.Errorf("expected exit code 0 but got %d:\n%s", code, out.String())
+	}
+	if !strings.Contains(out.String(), "stdin:1: 1.2.3: Semantic Version is valid") {
+		t.Errorf("unexpected output:\n%s", out.String())
+	}
+
+	out.Reset()
+	if code := validateFile(&out, &errOut, nil, filepath.Join(root, "missing.txt")); code != 7 {
+		t.Errorf("expected exit code 7 but got %d", code)
+	}
+	if out.Len() != 0 || !strings.Contains(errOut.String(), "Unable to read versions") {
+		t.Errorf("expected the read error only on stderr:\n%s\n%s", out.String(), errOut.String())
+	}
+}
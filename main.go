@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
@@ -11,17 +12,62 @@ import (
 )
 
 func main() {
+	// Cobra has already displayed the error, such as an unknown flag
+	if err := newRootCmd().Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+// newRootCmd returns the command used to validate versions. Flags that only
+// apply to validating versions are local to it while those that change how a
+// version is read are persistent so the subcommands share them.
+func newRootCmd() *cobra.Command {
 	var cmd = &cobra.Command{
 		Use:   "semver-isvalid [version]",
 		Short: "semver-isvalid allows you to validate a single semantic version",
 		Long:  longdesc,
+		Args:  cobra.ArbitraryArgs,
 		Run: func(cmd *cobra.Command, args []string) {
 
 			if disableColor {
 				color.NoColor = true
 			}
 
+			if msg := flagError(); msg != "" {
+				red.Fprintln(os.Stderr, msg)
+				os.Exit(1)
+			}
+
 			la := len(args)
+			if dir != "" {
+				if la != 0 {
+					red.Fprintf(os.Stderr, "Wrong number of arguments supplied. No arguments are used with --dir but found %d\n", la)
+					os.Exit(1)
+				}
+				os.Exit(validateDir(os.Stdout, os.Stderr, dir, versionFileGlob))
+			}
+
+			if file != "" {
+				if la != 0 {
+					red.Fprintf(os.Stderr, "Wrong number of arguments supplied. No arguments are used with --file but found %d\n", la)
+					os.Exit(1)
+				}
+				os.Exit(validateFile(os.Stdout, os.Stderr, os.Stdin, file))
+			}
+
+			switch input {
+			case "":
+			case "json":
+				if la != 0 {
+					red.Fprintf(os.Stderr, "Wrong number of arguments supplied. No arguments are used with --input but found %d\n", la)
+					os.Exit(1)
+				}
+				os.Exit(validateJSON(os.Stdin, os.Stdout, os.Stderr))
+			default:
+				red.Fprintf(os.Stderr, "Unknown input format %q. The supported format is json\n", input)
+				os.Exit(1)
+			}
+
 			if la == 0 {
 				_ = cmd.Help()
 				return
@@ -29,20 +75,51 @@ func main() {
 				red.Fprintf(os.Stderr, "Wrong number of arguments supplied. 1 argument required but found %d\n", la)
 				os.Exit(1)
 			}
-			validate(args[0])
+
+			switch output {
+			case "text":
+				os.Exit(validate(os.Stdout, os.Stderr, args[0]))
+			case "shields":
+				os.Exit(validateShields(os.Stdout, args[0]))
+			default:
+				red.Fprintf(os.Stderr, "Unknown output format %q. The supported formats are text and shields\n", output)
+				os.Exit(1)
+			}
 		},
 	}
 
 	cmd.PersistentFlags().BoolVar(&withV, "with-v", false, "allow v at start of version")
+	cmd.PersistentFlags().BoolVar(&warnV, "warn-v", false, "allow v at start of version with a notice that it is non-standard")
 	cmd.PersistentFlags().BoolVar(&disableColor, "disable-color", false, "disable use of color in output")
+	cmd.PersistentFlags().BoolVar(&gitDescribe, "git-describe", false, "allow a version produced by git describe")
+	cmd.Flags().StringVar(&dir, "dir", "", "validate the version files found in a directory tree")
+	cmd.Flags().StringVar(&expect, "expect", "", "check that the version matches an expected version")
+	cmd.Flags().BoolVar(&strictMetadata, "strict-metadata", false, "include build metadata when matching the expected version")
+	cmd.Flags().StringVar(&baselineFile, "baseline-file", "", "check that the version is newer than the version in a file")
+	cmd.Flags().StringVar(&output, "output", "text", "the output format for a version (text, shields)")
+	cmd.Flags().StringVar(&file, "file", "", "validate the versions in a file, one per line, or - for stdin")
+	cmd.Flags().StringVar(&input, "input", "", "read the versions from stdin in a format (json)")
+	cmd.Flags().StringVar(&versionFileGlob, "version-file-glob", "VERSION", "the pattern for names of version files used with --dir")
+
+	cmd.AddCommand(newSatisfiesCmd())
 
-	cmd.Execute()
+	return cmd
 }
 
 var red = color.New(color.FgRed)
 
 var withV = false
+var warnV = false
 var disableColor = false
+var gitDescribe = false
+var dir = ""
+var versionFileGlob = "VERSION"
+var input = ""
+var file = ""
+var expect = ""
+var strictMetadata = false
+var baselineFile = ""
+var output = "text"
 
 const longdesc = `semver-isvalid allows you to validate a single semantic version
 
@@ -78,16 +155,104 @@ example:
 
 Without the --with-v this would have returned an error as being invalid.
 
+When migrating away from a "v" at the start of versions the --warn-v flag can
+be used instead. It allows the "v" like --with-v but also displays a notice
+that the "v" is not part of Semantic Versioning. For example:
+
+    $ semver-isvalid v1.2.3 --warn-v
+    Found major version of 1
+    Found minor version of 2
+    Found patch version of 3
+    NOTICE: The leading "v" is not part of Semantic Versioning. It was removed to validate the version but tools following the specification may not accept it.
+    Semantic Version is valid
+
+Versions produced by git describe, which are commonly passed to Go builds
+using -ldflags, can be validated using the --git-describe flag. The leading
+"v" is removed and the suffix with the number of commits and the commit hash
+is reported. For example:
+
+    $ semver-isvalid v1.2.3-14-gabcdef --git-describe
+    Found major version of 1
+    Found minor version of 2
+    Found patch version of 3
+    Found 14 commits since the tagged version "1.2.3"
+    Found commit hash of "abcdef"
+    NOTICE: The git describe suffix is treated like pre-release information. The version is a development build and not the tagged release.
+    Semantic Version is valid
+
+To validate the version files across a directory tree, such as in a monorepo,
+use the --dir flag. Files named VERSION are read and each one is reported. A
+different file name pattern can be set using --version-file-glob. For example:
+
+    $ semver-isvalid --dir . --version-file-glob "*.version"
+
+A list of versions, one per line, can be validated using --file with the path
+to the file or - to read the versions from stdin. Empty lines, such as a
+trailing blank line, are skipped and not counted as versions. This differs
+from passing a single empty version, which is invalid. For example:
+
+    $ git tag | semver-isvalid --file - --with-v
+
+Tools that work with JSON can validate a batch of versions by passing a JSON
+array of versions on stdin with --input json. The output is a JSON array with
+the result for each version. For example:
+
+    $ echo '["1.2.3", "1.2"]' | semver-isvalid --input json
+
+To check that a version is the one you expect, such as a tag matching the
+version in a manifest, use the --expect flag. The versions are matched by
+precedence so build metadata is ignored unless --strict-metadata is used. It
+can only be used when validating a single version with the text output. For
+example:
+
+    $ semver-isvalid 1.2.3 --expect 1.2.4
+    Found major version of 1
+    Found minor version of 2
+    Found patch version of 3
+    Semantic Version is valid
+    Version 1.2.3 does not match the expected version 1.2.4
+
+To only publish when the version has increased, compare it to the last
+published version kept in a file using --baseline-file. The version must be
+newer by precedence than the version in the file. It can only be used when
+validating a single version with the text output. For example:
+
+    $ semver-isvalid 1.2.3 --baseline-file VERSION
+    Found major version of 1
+    Found minor version of 2
+    Found patch version of 3
+    Semantic Version is valid
+    Version 1.2.3 is not newer than the baseline version 1.2.3 in VERSION
+
+To check if a version satisfies a constraint use the satisfies command. See
+"semver-isvalid satisfies --help" for details.
+
+A badge showing if a version is valid can be created using the JSON endpoint
+format of shields.io. Use --output shields to output the badge JSON. It can
+only be used when validating a single version. For example:
+
+    $ semver-isvalid 1.2.3 --output shields
+    {"schemaVersion":1,"label":"semver","message":"valid","color":"green"}
+
 For those who look at exit codes, each type of error has a unique exit code.
 The codes include:
 
-- 1: Invalid number of arguments passed to application
+- 1: Invalid number of arguments passed to application or flags used in an
+     unsupported combination
 - 2: A general invalid semantic version
 - 3: The version passed in evaluates to an empty string
 - 4: There are an invalid number of version parts. 3 are required for Semantic
      Versions
 - 5: Invalid characters were found in a part of a Semantic Version
 - 6: A numeric segment starts with 0
+- 7: Unable to read an input file or directory, or the --version-file-glob is
+     invalid
+- 8: One or more of the versions validated together are invalid
+- 9: The input could not be parsed in the format given by --input
+- 10: The version does not satisfy the constraint passed to satisfies
+- 11: The constraint passed to satisfies is invalid
+- 12: The version does not match the version passed to --expect
+- 13: The version is not newer than the version in the --baseline-file
 
 For more information on Semantic Versions please visit the specification
 at https://semver.org.
@@ -97,37 +262,137 @@ https://github.com/mattfarina/semver-isvalid
 
 `
 
-func validate(ver string) {
+func validate(out, errOut io.Writer, ver string) int {
+	msgs, err := check(ver)
+	for _, v := range msgs {
+		fmt.Fprintln(out, v)
+	}
+
+	if err != nil {
+		red.Fprintf(errOut, "%s\n", invalidMessage(err))
+		return exitCode(err)
+	}
+
+	fmt.Fprintln(out, "Semantic Version is valid")
+
+	if expect != "" {
+		if code := checkExpected(out, errOut, ver); code != 0 {
+			return code
+		}
+	}
+
+	if baselineFile != "" {
+		return checkBaseline(out, errOut, ver)
+	}
+
+	return 0
+}
+
+// flagError returns a message when flags are used in a combination that is
+// not supported, or an empty string when they are fine. Checks such as
+// --expect and --baseline-file are only run when validating a single version
+// with text output so they are rejected elsewhere rather than silently skipped.
+func flagError() string {
+	if strictMetadata && expect == "" {
+		return "The --strict-metadata flag can only be used with --expect"
+	}
+
+	var modes []string
+	for _, m := range []struct{ flag, value string }{{"--dir", dir}, {"--file", file}, {"--input", input}} {
+		if m.value != "" {
+			modes = append(modes, m.flag)
+		}
+	}
+	if len(modes) > 1 {
+		return fmt.Sprintf("Only one of --dir, --file, and --input can be used but found %s", strings.Join(modes, ", "))
+	}
+
+	if output != "text" && len(modes) > 0 {
+		return fmt.Sprintf("The --output %s flag can only be used when validating a single version", output)
+	}
 
-	if withV {
+	var flag string
+	switch {
+	case expect != "":
+		flag = "--expect"
+	case baselineFile != "":
+		flag = "--baseline-file"
+	default:
+		return ""
+	}
+
+	switch {
+	case dir != "":
+		return fmt.Sprintf("The %s flag cannot be used with --dir", flag)
+	case file != "":
+		return fmt.Sprintf("The %s flag cannot be used with --file", flag)
+	case input != "":
+		return fmt.Sprintf("The %s flag cannot be used with --input", flag)
+	case output != "text":
+		return fmt.Sprintf("The %s flag cannot be used with --output %s", flag, output)
+	}
+
+	return ""
+}
+
+// stripV removes a leading v from the version when the flags allow one.
+func stripV(ver string) string {
+	if withV || warnV || gitDescribe {
+		return strings.TrimPrefix(ver, "v")
+	}
+
+	return ver
+}
+
+// check validates the version as set by the flags and returns the messages
+// about the version along with any error.
+func check(ver string) ([]string, error) {
+	var notice string
+	if warnV && strings.HasPrefix(ver, "v") {
+		ver = strings.TrimPrefix(ver, "v")
+		notice = "NOTICE: The leading \"v\" is not part of Semantic Versioning. It was removed to validate the version but tools following the specification may not accept it."
+	} else if withV {
 		ver = strings.TrimPrefix(ver, "v")
 	}
 
-	err, msgs := semver.Validate(ver)
-	for _, v := range msgs {
-		fmt.Println(v)
+	var msgs []string
+	var err error
+	if gitDescribe {
+		_, msgs, err = semver.ParseGitDescribe(ver)
+	} else {
+		err, msgs = semver.Validate(ver)
 	}
 
-	errmsg := "Invalid Semantic Version: %s. For more information see https://semver.org\n"
+	if notice != "" {
+		msgs = append(msgs, notice)
+	}
+
+	return msgs, err
+}
+
+// invalidMessage returns the message displayed for an invalid version.
+func invalidMessage(err error) string {
+	if exitCode(err) == 2 {
+		return "Invalid Semantic Version. For more information see https://semver.org"
+	}
+
+	return fmt.Sprintf("Invalid Semantic Version: %s. For more information see https://semver.org", err)
+}
+
+// exitCode returns the unique exit code for each type of validation error.
+func exitCode(err error) int {
 	switch err {
+	case nil:
+		return 0
 	case semver.ErrEmptyString:
-		red.Fprintf(os.Stderr, errmsg, semver.ErrEmptyString)
-		os.Exit(3)
+		return 3
 	case semver.ErrInvalidNumberParts:
-		red.Fprintf(os.Stderr, errmsg, semver.ErrInvalidNumberParts)
-		os.Exit(4)
-
+		return 4
 	case semver.ErrInvalidCharacters:
-		red.Fprintf(os.Stderr, errmsg, semver.ErrInvalidCharacters)
-		os.Exit(5)
-
+		return 5
 	case semver.ErrSegmentStartsZero:
-		red.Fprintf(os.Stderr, errmsg, semver.ErrSegmentStartsZero)
-		os.Exit(6)
-	case nil:
-		fmt.Println("Semantic Version is valid")
+		return 6
 	default:
-		red.Fprint(os.Stderr, "Invalid Semantic Version. For more information see https://semver.org\n")
-		os.Exit(2)
+		return 2
 	}
 }
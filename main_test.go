@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestValidateVPrefix(t *testing.T) {
+	notice := `NOTICE: The leading "v" is not part of Semantic Versioning.`
+
+	tests := []struct {
+		version      string
+		withV, warnV bool
+		code         int
+		notice       bool
+	}{
+		{"v1.2.3", false, false, 5, false},
+		{"v1.2.3", true, false, 0, false},
+		{"v1.2.3", false, true, 0, true},
+		{"v1.2.3", true, true, 0, true},
+		{"1.2.3", false, true, 0, false},
+		{"v1.2", false, true, 4, true},
+	}
+
+	defer func() { withV, warnV = false, false }()
+	for _, tc := range tests {
+		withV, warnV = tc.withV, tc.warnV
+
+		var out, errOut bytes.Buffer
+		code := validate(&out, &errOut, tc.version)
+		if code != tc.code {
+			t.Errorf("expected exit code %d for %s but got %d", tc.code, tc.version, code)
+		}
+
+		if strings.Contains(out.String(), notice) != tc.notice {
+			t.Errorf("expected notice for %s with --with-v=%t --warn-v=%t to be %t:\n%s", tc.version, tc.withV, tc.warnV, tc.notice, out.String())
+		}
+	}
+}
+
+func TestValidateEmpty(t *testing.T) {
+	var out, errOut bytes.Buffer
+	if code := validate(&out, &errOut, ""); code != 3 {
+		t.Errorf("expected exit code 3 but got %d", code)
+	}
+}
+
+func TestValidateGitDescribe(t *testing.T) {
+	notice := "The version is a development build"
+
+	tests := []struct {
+		version string
+		code    int
+		notice  bool
+		message string
+	}{
+		{"v1.2.3", 0, false, "Semantic Version is valid"},
+		{"v1.2.3-14-gabcdef", 0, true, `Found 14 commits since the tagged version "1.2.3"`},
+		{"v1.2.3-0-gabcdef", 0, false, `Found commit hash of "abcdef"`},
+		{"v1.2.3-dirty", 0, true, "Found modifications in the working tree"},
+		{"v1.2-14-gabcdef", 4, false, "Found 2 number of parts"},
+	}
+
+	defer func() { gitDescribe = false }()
+	gitDescribe = true
+	for _, tc := range tests {
+		var out, errOut bytes.Buffer
+		if code := validate(&out, &errOut, tc.version); code != tc.code {
+			t.Errorf("expected exit code %d for %s but got %d", tc.code, tc.version, code)
+		}
+
+		if strings.Contains(out.String(), notice) != tc.notice {
+			t.Errorf("expected development notice for %s to be %t:\n%s", tc.version, tc.notice, out.String())
+		}
+		if !strings.Contains(out.String(), tc.message) {
+			t.Errorf("expected %q in output for %s:\n%s", tc.message, tc.version, out.String())
+		}
+	}
+}
+
+func TestFlagError(t *testing.T) {
+	tests := []struct {
+		dir, file, input, output string
+		message                  string
+	}{
+		{"", "", "", "shields", ""},
+		{".", "", "", "text", ""},
+		{".", "", "", "shields", "The --output shields flag can only be used when validating a single version"},
+		{"", "-", "", "shields", "The --output shields flag can only be used when validating a single version"},
+		{"", "", "json", "shields", "The --output shields flag can only be used when validating a single version"},
+		{".", "-", "", "text", "Only one of --dir, --file, and --input can be used but found --dir, --file"},
+		{"", "-", "json", "text", "Only one of --dir, --file, and --input can be used but found --file, --input"},
+		{".", "", "json", "text", "Only one of --dir, --file, and --input can be used but found --dir, --input"},
+		{".", "-", "json", "text", "Only one of --dir, --file, and --input can be used but found --dir, --file, --input"},
+	}
+
+	defer func() { dir, file, input, output = "", "", "", "text" }()
+	for _, tc := range tests {
+		dir, file, input, output = tc.dir, tc.file, tc.input, tc.output
+		if msg := flagError(); msg != tc.message {
+			t.Errorf("expected %q for %+v but got %q", tc.message, tc, msg)
+		}
+	}
+}
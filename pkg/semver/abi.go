@@ -0,0 +1,43 @@
+package semver
+
+import "errors"
+
+// ErrInvalidABIPolicy is returned when an unknown ABIPolicy is used.
+var ErrInvalidABIPolicy = errors.New("Invalid ABI policy")
+
+// ABIPolicy is the rule used to decide if two versions are ABI compatible.
+type ABIPolicy int
+
+const (
+	// ABISameMajor treats versions with the same major version as compatible
+	ABISameMajor ABIPolicy = iota
+
+	// ABISameMinor treats versions with the same major and minor version as
+	// compatible
+	ABISameMinor
+)
+
+// ABICompatible validates both versions and returns true when they are ABI
+// compatible under the policy. The policy is applied as is, without the
+// special handling of 0.x versions that the ^ constraint has, so a library
+// with a different rule for 0.x versions should use ABISameMinor for them.
+func ABICompatible(a, b string, policy ABIPolicy) (bool, error) {
+	va, _, err := parse(a)
+	if err != nil {
+		return false, err
+	}
+
+	vb, _, err := parse(b)
+	if err != nil {
+		return false, err
+	}
+
+	switch policy {
+	case ABISameMajor:
+		return va.major == vb.major, nil
+	case ABISameMinor:
+		return va.major == vb.major && va.minor == vb.minor, nil
+	}
+
+	return false, ErrInvalidABIPolicy
+}
@@ -0,0 +1,36 @@
+package semver
+
+import "testing"
+
+func TestABICompatible(t *testing.T) {
+	tests := []struct {
+		a, b       string
+		policy     ABIPolicy
+		compatible bool
+	}{
+		{"1.2.0", "1.9.0", ABISameMajor, true},
+		{"1.9.0", "1.2.0", ABISameMajor, true},
+		{"1.2.0", "2.0.0", ABISameMajor, false},
+		{"1.2.0", "1.2.7", ABISameMinor, true},
+		{"1.2.0-rc.1", "1.2.7+build", ABISameMinor, true},
+		{"1.2.0", "1.3.0", ABISameMinor, false},
+		{"1.2.0", "2.2.0", ABISameMinor, false},
+	}
+
+	for _, tc := range tests {
+		compatible, err := ABICompatible(tc.a, tc.b, tc.policy)
+		if err != nil {
+			t.Fatalf("error for %s and %s: %s", tc.a, tc.b, err)
+		}
+		if compatible != tc.compatible {
+			t.Errorf("expected %s and %s compatible under policy %d to be %t but got %t", tc.a, tc.b, tc.policy, tc.compatible, compatible)
+		}
+	}
+
+	if _, err := ABICompatible("1.2.0", "1.2", ABISameMajor); err != ErrInvalidNumberParts {
+		t.Errorf("expected error %q but got %v", ErrInvalidNumberParts, err)
+	}
+	if _, err := ABICompatible("1.2.0", "1.2.0", ABIPolicy(7)); err != ErrInvalidABIPolicy {
+		t.Errorf("expected error %q but got %v", ErrInvalidABIPolicy, err)
+	}
+}
@@ -0,0 +1,222 @@
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var (
+	// ErrVersionNotFound is returned when a version is not found in a list of
+	// versions.
+	ErrVersionNotFound = errors.New("Version not found")
+
+	// ErrInvalidLevel is returned when a level other than patch or minor is
+	// used.
+	ErrInvalidLevel = errors.New("Invalid level. Must be patch or minor")
+
+	// ErrTooManyMissing is returned when more than MaxMissing versions are
+	// missing from a list of versions.
+	ErrTooManyMissing = errors.New("Too many missing versions")
+)
+
+// MaxMissing is the largest number of missing versions IsContiguous reports.
+// Larger gaps return ErrTooManyMissing rather than listing every version.
+const MaxMissing = 1000
+
+// Partition validates each of the versions and splits them into stable and
+// pre-release versions. A version with only build metadata is stable. Both
+// lists are sorted in ascending order by precedence.
+func Partition(versions []string) (stable, prerelease []string, err error) {
+	vs, err := parseAll(versions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var sv, pv []*Version
+	for i, v := range vs {
+		if v.pre == "" {
+			stable = append(stable, versions[i])
+			sv = append(sv, v)
+		} else {
+			prerelease = append(prerelease, versions[i])
+			pv = append(pv, v)
+		}
+	}
+
+	sortVersions(stable, sv)
+	sortVersions(prerelease, pv)
+
+	return stable, prerelease, nil
+}
+
+// Rank validates the versions and returns the 1-based position of the version
+// when all of the versions are sorted in ascending order by precedence, where
+// 1 is the oldest. The version must be in the list, with build metadata being
+// ignored, or ErrVersionNotFound is returned. Versions with the same
+// precedence share the same rank, which is the position of the first of them,
+// and the next version has a rank that skips over the others (e.g. 1, 2, 2, 4).
+func Rank(ver string, all []string) (int, error) {
+	v, _, err := parse(ver)
+	if err != nil {
+		return 0, err
+	}
+
+	vs, err := parseAll(all)
+	if err != nil {
+		return 0, err
+	}
+
+	found := false
+	rank := 1
+	for _, o := range vs {
+		switch v.compare(o) {
+		case 0:
+			found = true
+		case 1:
+			rank++
+		}
+	}
+
+	if !found {
+		return 0, fmt.Errorf("%w: %s", ErrVersionNotFound, ver)
+	}
+
+	return rank, nil
+}
+
+// LatestPerMajor validates the versions and returns a map of each major
+// version to the version with the highest precedence on that major line. A
+// pre-release has a lower precedence than the release it is for.
+func LatestPerMajor(versions []string) (map[uint64]string, error) {
+	vs, err := parseAll(versions)
+	if err != nil {
+		return nil, err
+	}
+
+	latest := make(map[uint64]int)
+	for i, v := range vs {
+		if l, ok := latest[v.major]; !ok || v.compare(vs[l]) > 0 {
+			latest[v.major] = i
+		}
+	}
+
+	m := make(map[uint64]string, len(latest))
+	for major, i := range latest {
+		m[major] = versions[i]
+	}
+
+	return m, nil
+}
+
+// IsContiguous validates the versions and checks that there are no gaps in
+// them at the level, which is either patch or minor. At the patch level each
+// major.minor line must have every patch version between its lowest and
+// highest one (e.g. 1.2.0, 1.2.1, and 1.2.3 are missing 1.2.2). At the minor
+// level each major line must have every minor version between its lowest and
+// highest one, with missing ones reported as the .0 release. Pre-releases are
+// ignored. The versions do not need to be sorted. It returns true when there
+// are no gaps along with the missing versions, in ascending order, when there
+// are. When more than MaxMissing versions are missing ErrTooManyMissing is
+// returned.
+func IsContiguous(versions []string, level string) (bool, []string, error) {
+	if level != "patch" && level != "minor" {
+		return false, nil, ErrInvalidLevel
+	}
+
+	vs, err := parseAll(versions)
+	if err != nil {
+		return false, nil, err
+	}
+
+	// Track the parts found on each line, which is major.minor at the patch
+	// level and major at the minor level.
+	type line struct{ major, minor uint64 }
+	found := make(map[line]map[uint64]bool)
+	for _, v := range vs {
+		if v.pre != "" {
+			continue
+		}
+
+		l, p := line{v.major, v.minor}, v.patch
+		if level == "minor" {
+			l, p = line{major: v.major}, v.minor
+		}
+
+		if found[l] == nil {
+			found[l] = make(map[uint64]bool)
+		}
+		found[l][p] = true
+	}
+
+	// Find the range of each line and count the missing versions before
+	// listing them so a large gap does not create a huge list.
+	type span struct{ min, max uint64 }
+	spans := make(map[line]span, len(found))
+	var count uint64
+	for l, parts := range found {
+		var sp span
+		first := true
+		for p := range parts {
+			if first || p < sp.min {
+				sp.min = p
+			}
+			if first || p > sp.max {
+				sp.max = p
+			}
+			first = false
+		}
+		spans[l] = sp
+
+		// Every part found is within the range so this cannot underflow
+		gap := sp.max - sp.min - uint64(len(parts)-1)
+		if gap > MaxMissing-count {
+			return false, nil, fmt.Errorf("%w: more than %d", ErrTooManyMissing, MaxMissing)
+		}
+		count += gap
+	}
+
+	var missing []*Version
+	for l, parts := range found {
+		for p := spans[l].min; p < spans[l].max; p++ {
+			if parts[p] {
+				continue
+			}
+			if level == "minor" {
+				missing = append(missing, &Version{major: l.major, minor: p})
+			} else {
+				missing = append(missing, &Version{major: l.major, minor: l.minor, patch: p})
+			}
+		}
+	}
+
+	sort.Slice(missing, func(i, j int) bool {
+		return missing[i].compare(missing[j]) < 0
+	})
+
+	var m []string
+	for _, v := range missing {
+		m = append(m, v.String())
+	}
+
+	return len(m) == 0, m, nil
+}
+
+// sortVersions sorts the version strings, along with their parsed versions,
+// in ascending order by precedence. Versions with the same precedence keep
+// their order.
+func sortVersions(versions []string, vs []*Version) {
+	sort.Stable(byPrecedence{versions, vs})
+}
+
+type byPrecedence struct {
+	versions []string
+	vs       []*Version
+}
+
+func (b byPrecedence) Len() int           { return len(b.vs) }
+func (b byPrecedence) Less(i, j int) bool { return b.vs[i].compare(b.vs[j]) < 0 }
+func (b byPrecedence) Swap(i, j int) {
+	b.versions[i], b.versions[j] = b.versions[j], b.versions[i]
+	b.vs[i], b.vs[j] = b.vs[j], b.vs[i]
+}
@@ -0,0 +1,152 @@
+package semver
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestPartition(t *testing.T) {
+	versions := []string{"1.2.0", "1.3.0-rc.1", "1.0.0+build", "2.0.0-alpha", "1.1.0", "1.3.0-beta.2"}
+
+	stable, prerelease, err := Partition(versions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := []string{"1.0.0+build", "1.1.0", "1.2.0"}; !reflect.DeepEqual(stable, expected) {
+		t.Errorf("expected stable versions %v but got %v", expected, stable)
+	}
+	if expected := []string{"1.3.0-beta.2", "1.3.0-rc.1", "2.0.0-alpha"}; !reflect.DeepEqual(prerelease, expected) {
+		t.Errorf("expected pre-release versions %v but got %v", expected, prerelease)
+	}
+
+	// The input should not be reordered
+	if versions[0] != "1.2.0" {
+		t.Errorf("expected the versions passed in to be unchanged but got %v", versions)
+	}
+
+	if _, _, err := Partition([]string{"1.2.0", "1.2"}); !errors.Is(err, ErrInvalidNumberParts) {
+		t.Errorf("expected error %q but got %v", ErrInvalidNumberParts, err)
+	}
+}
+
+func TestRank(t *testing.T) {
+	all := []string{"1.2.0", "2.0.0", "1.0.0", "1.3.0-rc.1", "1.3.0", "1.1.0+build.1", "1.1.0+build.2"}
+
+	tests := []struct {
+		version string
+		rank    int
+		err     error
+	}{
+		{"1.0.0", 1, nil},
+		{"1.2.0", 4, nil},
+		{"1.3.0-rc.1", 5, nil},
+		{"2.0.0", 7, nil},
+		{"1.1.0", 2, nil},
+		{"1.1.0+build.2", 2, nil},
+		{"1.4.0", 0, ErrVersionNotFound},
+		{"1.4", 0, ErrInvalidNumberParts},
+	}
+
+	for _, tc := range tests {
+		rank, err := Rank(tc.version, all)
+		if !errors.Is(err, tc.err) {
+			t.Fatalf("expected error %v for %s but got %v", tc.err, tc.version, err)
+		}
+		if rank != tc.rank {
+			t.Errorf("expected rank of %s to be %d but got %d", tc.version, tc.rank, rank)
+		}
+	}
+
+	if _, err := Rank("1.0.0", []string{"1.0.0", "bad"}); !errors.Is(err, ErrInvalidNumberParts) {
+		t.Errorf("expected error %q but got %v", ErrInvalidNumberParts, err)
+	}
+}
+
+func TestLatestPerMajor(t *testing.T) {
+	versions := []string{"1.2.0", "1.10.1", "1.9.5", "2.0.0", "2.1.0-rc.1", "2.0.3", "1.11.0-beta.1", "3.0.0-alpha"}
+
+	latest, err := LatestPerMajor(versions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[uint64]string{
+		1: "1.11.0-beta.1",
+		2: "2.1.0-rc.1",
+		3: "3.0.0-alpha",
+	}
+	if !reflect.DeepEqual(latest, expected) {
+		t.Errorf("expected %v but got %v", expected, latest)
+	}
+
+	latest, err = LatestPerMajor([]string{"1.2.0", "1.2.1-rc.1", "1.2.1", "2.0.0-rc.1", "2.0.0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = map[uint64]string{1: "1.2.1", 2: "2.0.0"}
+	if !reflect.DeepEqual(latest, expected) {
+		t.Errorf("expected %v but got %v", expected, latest)
+	}
+
+	if _, err := LatestPerMajor([]string{"1.2.0", "2.0"}); !errors.Is(err, ErrInvalidNumberParts) {
+		t.Errorf("expected error %q but got %v", ErrInvalidNumberParts, err)
+	}
+}
+
+func TestIsContiguous(t *testing.T) {
+	tests := []struct {
+		versions   []string
+		level      string
+		contiguous bool
+		missing    []string
+	}{
+		{[]string{"1.2.0", "1.2.1", "1.2.3"}, "patch", false, []string{"1.2.2"}},
+		{[]string{"1.2.3", "1.2.0", "1.2.1", "1.2.2"}, "patch", true, nil},
+		{[]string{"1.2.0", "1.2.3", "1.3.1", "1.3.3", "1.2.4-rc.1"}, "patch", false, []string{"1.2.1", "1.2.2", "1.3.2"}},
+		{[]string{"1.0.0", "1.1.5", "1.3.0", "2.0.0", "2.2.0"}, "minor", false, []string{"1.2.0", "2.1.0"}},
+		{[]string{"1.0.0", "1.1.5", "1.2.0", "2.0.0"}, "minor", true, nil},
+		{[]string{}, "patch", true, nil},
+	}
+
+	for _, tc := range tests {
+		contiguous, missing, err := IsContiguous(tc.versions, tc.level)
+		if err != nil {
+			t.Fatalf("error for %v: %s", tc.versions, err)
+		}
+		if contiguous != tc.contiguous {
+			t.Errorf("expected %v contiguous at %s level to be %t but got %t", tc.versions, tc.level, tc.contiguous, contiguous)
+		}
+		if !reflect.DeepEqual(missing, tc.missing) {
+			t.Errorf("expected %v missing from %v but got %v", tc.missing, tc.versions, missing)
+		}
+	}
+
+	for _, tc := range [][]string{
+		{"1.2.0", "1.2.50000000"},
+		{"1.2.0", "1.2.18446744073709551615"},
+		{"0.0.0", "18446744073709551615.0.0", "18446744073709551615.18446744073709551615.0"},
+		{"1.2.0", "1.2.600", "1.3.0", "1.3.600"},
+	} {
+		level := "patch"
+		if len(tc) == 3 {
+			level = "minor"
+		}
+		if _, _, err := IsContiguous(tc, level); !errors.Is(err, ErrTooManyMissing) {
+			t.Errorf("expected error %q for %v but got %v", ErrTooManyMissing, tc, err)
+		}
+	}
+
+	contiguous, missing, err := IsContiguous([]string{"1.2.0", "1.2.1001"}, "patch")
+	if err != nil || contiguous || len(missing) != MaxMissing {
+		t.Errorf("expected %d missing versions but got %d and error %v", MaxMissing, len(missing), err)
+	}
+
+	if _, _, err := IsContiguous([]string{"1.2.0"}, "major"); err != ErrInvalidLevel {
+		t.Errorf("expected error %q but got %v", ErrInvalidLevel, err)
+	}
+	if _, _, err := IsContiguous([]string{"1.2.0", "1.2"}, "patch"); !errors.Is(err, ErrInvalidNumberParts) {
+		t.Errorf("expected error %q but got %v", ErrInvalidNumberParts, err)
+	}
+}
@@ -0,0 +1,168 @@
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrDowngrade is returned when a version has a lower precedence than the one
+// it is replacing.
+var ErrDowngrade = errors.New("Version is a downgrade")
+
+// Compare validates both versions and compares them by precedence. It returns
+// -1 when a is less than b, 0 when they have the same precedence, and 1 when a
+// is greater than b. Build metadata is ignored when determining precedence.
+func Compare(a, b string) (int, error) {
+	va, _, err := parse(a)
+	if err != nil {
+		return 0, err
+	}
+
+	vb, _, err := parse(b)
+	if err != nil {
+		return 0, err
+	}
+
+	return va.compare(vb), nil
+}
+
+// MeetsMinimum validates both versions and returns true if the version is
+// greater than or equal to the minimum by precedence. A pre-release of the
+// minimum, such as 1.2.0-rc1 for a minimum of 1.2.0, does not meet it.
+func MeetsMinimum(ver, minimum string) (bool, error) {
+	c, err := Compare(ver, minimum)
+	if err != nil {
+		return false, err
+	}
+
+	return c >= 0, nil
+}
+
+// WarnIfBelow validates both versions and, when the version has a lower
+// precedence than the floor, returns a message advising that it is no longer
+// supported along with true. It is meant to be advisory and does not return
+// an error for a version below the floor.
+func WarnIfBelow(ver, floor string) (string, bool, error) {
+	c, err := Compare(ver, floor)
+	if err != nil {
+		return "", false, err
+	}
+
+	if c < 0 {
+		return fmt.Sprintf("WARNING: Version %s is below the minimum supported version %s. Please upgrade to %s or newer.", ver, floor, floor), true, nil
+	}
+
+	return "", false, nil
+}
+
+// AssertNoDowngrade validates both versions and returns ErrDowngrade, wrapped
+// with both versions, when to has a lower precedence than from. Going to a
+// version with the same precedence, including one that only differs in build
+// metadata, is not a downgrade and nil is returned.
+func AssertNoDowngrade(from, to string) error {
+	c, err := Compare(to, from)
+	if err != nil {
+		return err
+	}
+
+	if c < 0 {
+		return fmt.Errorf("%w from %s to %s", ErrDowngrade, from, to)
+	}
+
+	return nil
+}
+
+// compare returns -1, 0, or 1 based on the precedence of v compared to o as
+// defined by the spec.
+func (v *Version) compare(o *Version) int {
+	if c := compareUint(v.major, o.major); c != 0 {
+		return c
+	}
+	if c := compareUint(v.minor, o.minor); c != 0 {
+		return c
+	}
+	if c := compareUint(v.patch, o.patch); c != 0 {
+		return c
+	}
+
+	return comparePrerelease(v.pre, o.pre)
+}
+
+func compareUint(a, b uint64) int {
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+
+	return 0
+}
+
+// comparePrerelease compares two pre-release strings. A version without a
+// pre-release has a higher precedence than one with one.
+func comparePrerelease(a, b string) int {
+	if a == b {
+		return 0
+	} else if a == "" {
+		return 1
+	} else if b == "" {
+		return -1
+	}
+
+	pa := strings.Split(a, ".")
+	pb := strings.Split(b, ".")
+	for i := 0; i < len(pa) && i < len(pb); i++ {
+		if c := compareIdentifier(pa[i], pb[i]); c != 0 {
+			return c
+		}
+	}
+
+	// A larger set of identifiers has a higher precedence when all of the
+	// preceding identifiers are equal.
+	if len(pa) < len(pb) {
+		return -1
+	} else if len(pa) > len(pb) {
+		return 1
+	}
+
+	return 0
+}
+
+// compareIdentifier compares a single pre-release identifier. Numeric
+// identifiers are compared numerically and have a lower precedence than
+// alphanumeric identifiers, which are compared lexically in ASCII order.
+func compareIdentifier(a, b string) int {
+	an := containsOnly(a, num)
+	bn := containsOnly(b, num)
+
+	switch {
+	case an && bn:
+		// Numeric identifiers can not have leading zeros so a longer one is
+		// larger. This avoids overflowing when parsing large identifiers.
+		if len(a) != len(b) {
+			return compareUint(uint64(len(a)), uint64(len(b)))
+		}
+		return strings.Compare(a, b)
+	case an:
+		return -1
+	case bn:
+		return 1
+	}
+
+	return strings.Compare(a, b)
+}
+
+// LooseEqual returns true when two versions are the same after removing any
+// leading v and ignoring the case of the pre-release and metadata. This is
+// intentionally lenient, for cases such as searching for a version a user
+// typed, and is not the precedence defined by the spec. Both versions must be
+// valid after the v is removed.
+func LooseEqual(a, b string) (bool, error) {
+	c, err := Compare(strings.ToLower(strings.TrimPrefix(a, "v")), strings.ToLower(strings.TrimPrefix(b, "v")))
+	if err != nil {
+		return false, err
+	}
+
+	return c == 0, nil
+}
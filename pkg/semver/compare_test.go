@@ -0,0 +1,178 @@
+package semver
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		c    int
+	}{
+		{"1.2.3", "1.2.3", 0},
+		{"1.2.3", "1.2.4", -1},
+		{"1.3.0", "1.2.9", 1},
+		{"2.0.0", "10.0.0", -1},
+		{"1.2.3+a", "1.2.3+b", 0},
+		{"1.0.0-alpha", "1.0.0", -1},
+		{"1.0.0-alpha", "1.0.0-alpha.1", -1},
+		{"1.0.0-alpha.1", "1.0.0-alpha.beta", -1},
+		{"1.0.0-alpha.beta", "1.0.0-beta", -1},
+		{"1.0.0-beta", "1.0.0-beta.2", -1},
+		{"1.0.0-beta.2", "1.0.0-beta.11", -1},
+		{"1.0.0-beta.11", "1.0.0-rc.1", -1},
+		{"1.0.0-rc.1", "1.0.0", -1},
+		{"1.0.0-rc.1", "1.0.0-rc.1+build", 0},
+	}
+
+	for _, tc := range tests {
+		c, err := Compare(tc.a, tc.b)
+		if err != nil {
+			t.Fatalf("error comparing %s and %s: %s", tc.a, tc.b, err)
+		}
+		if c != tc.c {
+			t.Errorf("expected comparing %s to %s to be %d but got %d", tc.a, tc.b, tc.c, c)
+		}
+
+		// The reverse comparison should be the inverse
+		c, _ = Compare(tc.b, tc.a)
+		if c != -tc.c {
+			t.Errorf("expected comparing %s to %s to be %d but got %d", tc.b, tc.a, -tc.c, c)
+		}
+	}
+
+	if _, err := Compare("1.2.3", "1.2"); err != ErrInvalidNumberParts {
+		t.Errorf("expected error %q but got %v", ErrInvalidNumberParts, err)
+	}
+}
+
+func TestMeetsMinimum(t *testing.T) {
+	tests := []struct {
+		version, minimum string
+		meets            bool
+		err              bool
+	}{
+		{"1.2.0", "1.2.0", true, false},
+		{"1.3.0", "1.2.0", true, false},
+		{"2.0.0", "1.2.0", true, false},
+		{"1.1.9", "1.2.0", false, false},
+		{"1.2.0-rc1", "1.2.0", false, false},
+		{"1.2.0", "1.2.0-rc1", true, false},
+		{"1.2.0+build", "1.2.0", true, false},
+		{"1.2", "1.2.0", false, true},
+		{"1.2.0", "v1.2.0", false, true},
+	}
+
+	for _, tc := range tests {
+		meets, err := MeetsMinimum(tc.version, tc.minimum)
+		if tc.err && err == nil {
+			t.Fatalf("expected error for %s and %s", tc.version, tc.minimum)
+		} else if !tc.err && err != nil {
+			t.Fatalf("error for %s and %s: %s", tc.version, tc.minimum, err)
+		}
+
+		if meets != tc.meets {
+			t.Errorf("expected %s meeting minimum %s to be %t but got %t", tc.version, tc.minimum, tc.meets, meets)
+		}
+	}
+}
+
+func TestLooseEqual(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		equal bool
+		err   bool
+	}{
+		{"v1.2.3", "1.2.3", true, false},
+		{"v1.2.3", "v1.2.3", true, false},
+		{"1.2.3-RC1", "1.2.3-rc1", true, false},
+		{"v1.2.3-Beta.1+BUILD", "1.2.3-beta.1+build", true, false},
+		{"1.2.3+a", "1.2.3+b", true, false},
+		{"1.2.3", "1.2.4", false, false},
+		{"1.2.3-rc1", "1.2.3", false, false},
+		{"V1.2.3", "1.2.3", false, true},
+		{"v1.2", "1.2.0", false, true},
+	}
+
+	for _, tc := range tests {
+		equal, err := LooseEqual(tc.a, tc.b)
+		if tc.err && err == nil {
+			t.Fatalf("expected error for %s and %s", tc.a, tc.b)
+		} else if !tc.err && err != nil {
+			t.Fatalf("error for %s and %s: %s", tc.a, tc.b, err)
+		}
+
+		if equal != tc.equal {
+			t.Errorf("expected %s and %s loosely equal to be %t but got %t", tc.a, tc.b, tc.equal, equal)
+		}
+	}
+}
+
+func TestAssertNoDowngrade(t *testing.T) {
+	tests := []struct {
+		from, to  string
+		downgrade bool
+	}{
+		{"1.2.3", "1.2.0", true},
+		{"1.2.3", "1.2.3-rc.1", true},
+		{"2.0.0", "1.9.9", true},
+		{"1.2.3", "1.2.4", false},
+		{"1.2.3-rc.1", "1.2.3", false},
+		{"1.2.3", "1.2.3", false},
+		{"1.2.3+a", "1.2.3+b", false},
+	}
+
+	for _, tc := range tests {
+		err := AssertNoDowngrade(tc.from, tc.to)
+		if tc.downgrade {
+			if !errors.Is(err, ErrDowngrade) {
+				t.Errorf("expected error %q from %s to %s but got %v", ErrDowngrade, tc.from, tc.to, err)
+			} else if !strings.Contains(err.Error(), tc.from) || !strings.Contains(err.Error(), tc.to) {
+				t.Errorf("expected error to name %s and %s but got %q", tc.from, tc.to, err)
+			}
+		} else if err != nil {
+			t.Errorf("unexpected error from %s to %s: %s", tc.from, tc.to, err)
+		}
+	}
+
+	if err := AssertNoDowngrade("1.2.3", "1.2"); err != ErrInvalidNumberParts {
+		t.Errorf("expected error %q but got %v", ErrInvalidNumberParts, err)
+	}
+}
+
+func TestWarnIfBelow(t *testing.T) {
+	tests := []struct {
+		version, floor string
+		warn           bool
+	}{
+		{"1.2.0", "2.0.0", true},
+		{"2.0.0-rc.1", "2.0.0", true},
+		{"2.0.0", "2.0.0", false},
+		{"2.0.0+build", "2.0.0", false},
+		{"2.1.0", "2.0.0", false},
+	}
+
+	for _, tc := range tests {
+		msg, warn, err := WarnIfBelow(tc.version, tc.floor)
+		if err != nil {
+			t.Fatalf("error for %s and %s: %s", tc.version, tc.floor, err)
+		}
+		if warn != tc.warn {
+			t.Errorf("expected warning for %s below %s to be %t but got %t", tc.version, tc.floor, tc.warn, warn)
+		}
+
+		if tc.warn {
+			if !strings.Contains(msg, tc.version) || !strings.Contains(msg, tc.floor) {
+				t.Errorf("expected message to name %s and %s but got %q", tc.version, tc.floor, msg)
+			}
+		} else if msg != "" {
+			t.Errorf("expected no message for %s and %s but got %q", tc.version, tc.floor, msg)
+		}
+	}
+
+	if _, _, err := WarnIfBelow("1.2", "2.0.0"); err != ErrInvalidNumberParts {
+		t.Errorf("expected error %q but got %v", ErrInvalidNumberParts, err)
+	}
+}
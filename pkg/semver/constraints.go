@@ -0,0 +1,389 @@
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// ErrInvalidConstraint is returned when a constraint cannot be parsed.
+var ErrInvalidConstraint = errors.New("Invalid constraint")
+
+// Constraints is a parsed constraint such as ">=1.2.0 <2.0.0 || ^3.1.0".
+//
+// Comparisons separated by a space or comma must all be satisfied while
+// groups of comparisons separated by || are alternatives where any one of them
+// must be satisfied. The supported operators are =, !=, >, >=, <, <=, ^ (same
+// major version, or minor for 0.x versions), and ~ (same minor version). A * on
+// its own matches any version.
+//
+// A version followed by -* (e.g. 1.2.3-*) matches any pre-release of that
+// version. It is the same as >=1.2.3-0 <1.2.3, as 0 is the lowest pre-release,
+// so 1.2.3-rc.1 matches while the release 1.2.3 and pre-releases of other
+// versions, such as 1.2.4-rc.1, do not. The version must not have a pre-release
+// or build metadata of its own.
+//
+// A version with a pre-release only satisfies a group of comparisons when one
+// of them has a pre-release on the same major, minor, and patch version. This
+// keeps a pre-release, such as 2.0.0-beta.1, from satisfying <2.0.0.
+type Constraints struct {
+	orig   string
+	groups [][]*constraint
+}
+
+// constraint is a single comparison such as >=1.2.0.
+type constraint struct {
+	orig string
+	op   string
+	ver  *Version
+}
+
+// The operators are in an order where those that are the prefix of another
+// come after it.
+var operators = []string{">=", "<=", "!=", ">", "<", "=", "^", "~"}
+
+// NewConstraint parses a constraint. Each version in the constraint must be a
+// valid semantic version.
+func NewConstraint(c string) (*Constraints, error) {
+	cs := &Constraints{orig: c}
+	for _, g := range strings.Split(c, "||") {
+		fields := strings.FieldsFunc(g, func(r rune) bool {
+			return r == ',' || r == ' ' || r == '\t'
+		})
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("%w %q: empty group of comparisons", ErrInvalidConstraint, c)
+		}
+
+		var group []*constraint
+		for i := 0; i < len(fields); i++ {
+			f := fields[i]
+
+			// Allow a space between the operator and version (e.g. >= 1.2.0)
+			if isOperator(f) && i+1 < len(fields) {
+				i++
+				f += fields[i]
+			}
+
+			con, err := parseConstraint(f)
+			if err != nil {
+				return nil, err
+			}
+			group = append(group, con)
+		}
+		cs.groups = append(cs.groups, group)
+	}
+
+	return cs, nil
+}
+
+func isOperator(s string) bool {
+	for _, o := range operators {
+		if s == o {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parseConstraint(c string) (*constraint, error) {
+	if c == "*" {
+		return &constraint{orig: c, op: c}, nil
+	}
+
+	if strings.HasSuffix(c, "-*") {
+		v, _, err := parse(strings.TrimSuffix(c, "-*"))
+		if err != nil {
+			return nil, fmt.Errorf("%w %q: %s", ErrInvalidConstraint, c, err)
+		}
+		if v.pre != "" || v.metadata != "" {
+			return nil, fmt.Errorf("%w %q: version for any pre-release cannot have a pre-release or metadata", ErrInvalidConstraint, c)
+		}
+		return &constraint{orig: c, op: "-*", ver: v}, nil
+	}
+
+	con := &constraint{orig: c, op: "="}
+	ver := c
+	for _, o := range operators {
+		if strings.HasPrefix(c, o) {
+			con.op = o
+			ver = strings.TrimPrefix(c, o)
+			break
+		}
+	}
+
+	v, _, err := parse(ver)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %s", ErrInvalidConstraint, c, err)
+	}
+	con.ver = v
+
+	return con, nil
+}
+
+// String returns the constraint as it was passed in.
+func (cs *Constraints) String() string {
+	return cs.orig
+}
+
+// Check validates the version and returns true if it satisfies the
+// constraint.
+func (cs *Constraints) Check(ver string) (bool, error) {
+	v, _, err := parse(ver)
+	if err != nil {
+		return false, err
+	}
+
+	return cs.check(v), nil
+}
+
+func (cs *Constraints) check(v *Version) bool {
+	for _, g := range cs.groups {
+		if checkGroup(g, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkGroup returns true if the version satisfies every constraint in the
+// group.
+func checkGroup(g []*constraint, v *Version) bool {
+	for _, c := range g {
+		if !c.check(v) {
+			return false
+		}
+	}
+
+	return v.pre == "" || allowsPrerelease(g, v)
+}
+
+// allowsPrerelease returns true if a constraint in the group has a pre-release,
+// or is for any pre-release, on the same major, minor, and patch version as
+// the version.
+func allowsPrerelease(g []*constraint, v *Version) bool {
+	for _, c := range g {
+		if c.ver != nil && (c.ver.pre != "" || c.op == "-*") && c.ver.major == v.major && c.ver.minor == v.minor && c.ver.patch == v.patch {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *constraint) check(v *Version) bool {
+	switch c.op {
+	case "*":
+		return true
+	case "=":
+		return v.compare(c.ver) == 0
+	case "!=":
+		return v.compare(c.ver) != 0
+	case ">":
+		return v.compare(c.ver) > 0
+	case ">=":
+		return v.compare(c.ver) >= 0
+	case "<":
+		return v.compare(c.ver) < 0
+	case "<=":
+		return v.compare(c.ver) <= 0
+	case "^":
+		return v.compare(c.ver) >= 0 && below(v, caretUpper(c.ver))
+	case "~":
+		return v.compare(c.ver) >= 0 && below(v, next(c.ver, 1))
+	case "-*":
+		return v.pre != "" && v.major == c.ver.major && v.minor == c.ver.minor && v.patch == c.ver.patch
+	}
+
+	panic("Unknown constraint operator")
+}
+
+// caretUpper returns the first version that is not compatible with v, or nil
+// when there is none. For versions before 1.0.0 a change to the first non-zero
+// part is incompatible.
+func caretUpper(v *Version) *Version {
+	switch {
+	case v.major > 0:
+		return next(v, 0)
+	case v.minor > 0:
+		return next(v, 1)
+	}
+
+	return next(v, 2)
+}
+
+// next returns the lowest version after incrementing the part at i, where 0 is
+// major, 1 is minor, and 2 is patch. A part already at its maximum carries
+// into the part before it (e.g. the next minor of 1.18446744073709551615.0 is
+// 2.0.0). nil is returned when there is no higher version.
+func next(v *Version, i int) *Version {
+	parts := [3]uint64{v.major, v.minor, v.patch}
+	for ; i >= 0; i-- {
+		if parts[i] < math.MaxUint64 {
+			parts[i]++
+			for j := i + 1; j < 3; j++ {
+				parts[j] = 0
+			}
+			return &Version{major: parts[0], minor: parts[1], patch: parts[2]}
+		}
+	}
+
+	return nil
+}
+
+// below returns true if v is lower than the upper bound. A nil upper bound has
+// no limit.
+func below(v, upper *Version) bool {
+	return upper == nil || v.compare(upper) < 0
+}
+
+// OldestSatisfying returns the version in the list with the lowest precedence
+// that satisfies the constraint. An empty string is returned when no version
+// satisfies it. All of the versions and the constraint must be valid.
+func OldestSatisfying(versions []string, constraint string) (string, error) {
+	cs, err := NewConstraint(constraint)
+	if err != nil {
+		return "", err
+	}
+
+	vs, err := parseAll(versions)
+	if err != nil {
+		return "", err
+	}
+
+	oldest := -1
+	for i, v := range vs {
+		if cs.check(v) && (oldest == -1 || v.compare(vs[oldest]) < 0) {
+			oldest = i
+		}
+	}
+
+	if oldest == -1 {
+		return "", nil
+	}
+
+	return versions[oldest], nil
+}
+
+// Explanation details how a version was checked against a constraint.
+type Explanation struct {
+	// Satisfied is true when any of the groups is satisfied
+	Satisfied bool
+
+	// Groups has the explanation for each group of comparisons separated by
+	// || in the constraint
+	Groups []GroupExplanation
+}
+
+// GroupExplanation details how a version was checked against a group of
+// comparisons that must all be satisfied.
+type GroupExplanation struct {
+	// Satisfied is true when every comparison is satisfied and any
+	// pre-release on the version is allowed
+	Satisfied bool
+
+	// Comparisons has the result of each comparison in the group
+	Comparisons []ComparisonResult
+
+	// PrereleaseExcluded is true when the version is a pre-release that is
+	// not allowed because no comparison has a pre-release on the same major,
+	// minor, and patch version
+	PrereleaseExcluded bool
+}
+
+// ComparisonResult is the result of checking a version against a single
+// comparison such as >=1.2.0.
+type ComparisonResult struct {
+	Comparison string
+	Satisfied  bool
+}
+
+// Explain validates the version and checks it against each comparison in the
+// constraint, returning the result of every comparison rather than stopping
+// at the first one that fails.
+func (cs *Constraints) Explain(ver string) (*Explanation, error) {
+	v, _, err := parse(ver)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &Explanation{}
+	for _, g := range cs.groups {
+		ge := GroupExplanation{Satisfied: true}
+		for _, c := range g {
+			r := ComparisonResult{Comparison: c.orig, Satisfied: c.check(v)}
+			ge.Comparisons = append(ge.Comparisons, r)
+			ge.Satisfied = ge.Satisfied && r.Satisfied
+		}
+
+		if v.pre != "" && !allowsPrerelease(g, v) {
+			ge.PrereleaseExcluded = true
+			ge.Satisfied = false
+		}
+
+		e.Groups = append(e.Groups, ge)
+		e.Satisfied = e.Satisfied || ge.Satisfied
+	}
+
+	return e, nil
+}
+
+// Compatible validates both versions and returns true when the version a
+// provider offers is compatible with the version a consumer requires. This
+// uses the same rules as the ^ operator. The provider must have the same major
+// version and be the same or newer than the consumer requires. For versions
+// before 1.0.0 the minor version, or patch for 0.0.x, must also be the same.
+func Compatible(consumerRequires, providerOffers string) (bool, error) {
+	c, _, err := parse(consumerRequires)
+	if err != nil {
+		return false, err
+	}
+
+	p, _, err := parse(providerOffers)
+	if err != nil {
+		return false, err
+	}
+
+	return checkGroup([]*constraint{{orig: "^" + consumerRequires, op: "^", ver: c}}, p), nil
+}
+
+// SatisfyingDiff validates the versions and both constraints and reports how
+// changing a constraint from one to the other changes the versions that
+// satisfy it. Gained are the versions that satisfy to but not from while lost
+// are those that satisfy from but not to. Both are sorted in ascending order
+// by precedence.
+func SatisfyingDiff(versions []string, from, to string) (gained, lost []string, err error) {
+	fc, err := NewConstraint(from)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tc, err := NewConstraint(to)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vs, err := parseAll(versions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var gv, lv []*Version
+	for i, v := range vs {
+		f, t := fc.check(v), tc.check(v)
+		if t && !f {
+			gained = append(gained, versions[i])
+			gv = append(gv, v)
+		} else if f && !t {
+			lost = append(lost, versions[i])
+			lv = append(lv, v)
+		}
+	}
+
+	sortVersions(gained, gv)
+	sortVersions(lost, lv)
+
+	return gained, lost, nil
+}
@@ -0,0 +1,229 @@
+package semver
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestConstraints(t *testing.T) {
+	tests := []struct {
+		constraint string
+		version    string
+		check      bool
+	}{
+		{"1.2.3", "1.2.3", true},
+		{"=1.2.3", "1.2.3+build", true},
+		{"=1.2.3", "1.2.4", false},
+		{"!=1.2.3", "1.2.4", true},
+		{">1.2.3", "1.2.4", true},
+		{">1.2.3", "1.2.3", false},
+		{">=1.2.3", "1.2.3", true},
+		{"<1.2.3", "1.2.2", true},
+		{"<=1.2.3", "1.2.4", false},
+		{">= 1.2.0, < 1.4.0", "1.3.9", true},
+		{">=1.2.0 <1.4.0", "1.4.0", false},
+		{">=1.2.0 <1.4.0 || >=2.0.0", "1.5.0", false},
+		{">=1.2.0 <1.4.0 || >=2.0.0", "2.1.0", true},
+		{"^1.2.0", "1.9.9", true},
+		{"^1.2.0", "2.0.0", false},
+		{"^1.2.0", "1.1.0", false},
+		{"^0.2.3", "0.2.9", true},
+		{"^0.2.3", "0.3.0", false},
+		{"^0.0.3", "0.0.4", false},
+		{"~1.2.3", "1.2.9", true},
+		{"~1.2.3", "1.3.0", false},
+		{"*", "3.0.0", true},
+		{"<2.0.0", "2.0.0-beta.1", false},
+		{"^1.2.0", "1.3.0-rc.1", false},
+		{">=1.3.0-rc.1 <2.0.0", "1.3.0-rc.2", true},
+		{">=1.3.0-rc.1 <2.0.0", "1.4.0-rc.2", false},
+		{"1.2.3-*", "1.2.3-rc.1", true},
+		{"1.2.3-*", "1.2.3-0", true},
+		{"1.2.3-*", "1.2.3-alpha+build", true},
+		{"1.2.3-*", "1.2.3", false},
+		{"1.2.3-*", "1.2.4-rc.1", false},
+		{"1.2.3-*", "1.2.2-rc.1", false},
+		{"1.2.3-* || 1.2.4-*", "1.2.4-rc.1", true},
+		{"1.2.3-* !=1.2.3-rc.2", "1.2.3-rc.2", false},
+		{"^18446744073709551615.0.0", "18446744073709551615.0.0", true},
+		{"^18446744073709551615.0.0", "18446744073709551615.5.0", true},
+		{"^0.18446744073709551615.0", "0.18446744073709551615.3", true},
+		{"^0.18446744073709551615.0", "1.0.0", false},
+		{"^0.0.18446744073709551615", "0.0.18446744073709551615", true},
+		{"^0.0.18446744073709551615", "0.1.0", false},
+		{"~1.18446744073709551615.0", "1.18446744073709551615.3", true},
+		{"~1.18446744073709551615.0", "2.0.0", false},
+		{"~18446744073709551615.18446744073709551615.0", "18446744073709551615.18446744073709551615.5", true},
+	}
+
+	for _, tc := range tests {
+		cs, err := NewConstraint(tc.constraint)
+		if err != nil {
+			t.Fatalf("error parsing constraint %q: %s", tc.constraint, err)
+		}
+
+		check, err := cs.Check(tc.version)
+		if err != nil {
+			t.Fatalf("error checking %s: %s", tc.version, err)
+		}
+		if check != tc.check {
+			t.Errorf("expected %s satisfying %q to be %t but got %t", tc.version, tc.constraint, tc.check, check)
+		}
+	}
+}
+
+func TestNewConstraintInvalid(t *testing.T) {
+	for _, c := range []string{"", ">=1.2", "^1.2.0 ||", "=>1.2.0", "1.2.x", "1.2-*", "1.2.3-rc-*", ">=1.2.3-*"} {
+		if _, err := NewConstraint(c); !errors.Is(err, ErrInvalidConstraint) {
+			t.Errorf("expected error %q for %q but got %v", ErrInvalidConstraint, c, err)
+		}
+	}
+}
+
+func TestOldestSatisfying(t *testing.T) {
+	versions := []string{"2.0.0", "1.4.0", "1.2.5", "1.1.0", "1.2.0-rc.1", "1.3.0", "0.9.0"}
+
+	tests := []struct {
+		constraint string
+		oldest     string
+	}{
+		{"^1.2.0", "1.2.5"},
+		{"^1.0.0", "1.1.0"},
+		{"~1.3.0", "1.3.0"},
+		{"^3.0.0", ""},
+	}
+
+	for _, tc := range tests {
+		oldest, err := OldestSatisfying(versions, tc.constraint)
+		if err != nil {
+			t.Fatalf("error for constraint %q: %s", tc.constraint, err)
+		}
+		if oldest != tc.oldest {
+			t.Errorf("expected oldest satisfying %q to be %q but got %q", tc.constraint, tc.oldest, oldest)
+		}
+	}
+
+	if _, err := OldestSatisfying([]string{"1.2.0", "1.3"}, "^1.0.0"); !errors.Is(err, ErrInvalidNumberParts) {
+		t.Errorf("expected error %q but got %v", ErrInvalidNumberParts, err)
+	}
+	if _, err := OldestSatisfying(versions, "^1.0"); !errors.Is(err, ErrInvalidConstraint) {
+		t.Errorf("expected error %q but got %v", ErrInvalidConstraint, err)
+	}
+}
+
+func TestConstraintsExplain(t *testing.T) {
+	cs, err := NewConstraint(">=1.2.0 <1.4.0 || >=2.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e, err := cs.Explain("1.5.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if e.Satisfied {
+		t.Error("expected 1.5.0 to not satisfy the constraint")
+	}
+	if len(e.Groups) != 2 {
+		t.Fatalf("expected 2 groups but got %d", len(e.Groups))
+	}
+
+	expected := [][]ComparisonResult{
+		{{">=1.2.0", true}, {"<1.4.0", false}},
+		{{">=2.0.0", false}},
+	}
+	for i, g := range e.Groups {
+		if g.Satisfied {
+			t.Errorf("expected group %d to not be satisfied", i)
+		}
+		if len(g.Comparisons) != len(expected[i]) {
+			t.Fatalf("expected %d comparisons in group %d but got %d", len(expected[i]), i, len(g.Comparisons))
+		}
+		for j, c := range g.Comparisons {
+			if c != expected[i][j] {
+				t.Errorf("expected comparison %+v but got %+v", expected[i][j], c)
+			}
+		}
+	}
+
+	e, err = cs.Explain("1.3.0-rc.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.Satisfied || !e.Groups[0].PrereleaseExcluded || e.Groups[0].Comparisons[0].Satisfied != true {
+		t.Errorf("expected the pre-release to be excluded: %+v", e)
+	}
+
+	if _, err := cs.Explain("1.5"); err != ErrInvalidNumberParts {
+		t.Errorf("expected error %q but got %v", ErrInvalidNumberParts, err)
+	}
+}
+
+func TestCompatible(t *testing.T) {
+	tests := []struct {
+		consumer, provider string
+		compatible         bool
+	}{
+		{"1.2.0", "1.5.0", true},
+		{"1.2.0", "1.2.0", true},
+		{"1.2.0", "2.0.0", false},
+		{"1.2.0", "1.1.0", false},
+		{"1.2.0", "1.5.0-rc.1", false},
+		{"0.2.0", "0.2.5", true},
+		{"0.2.0", "0.3.0", false},
+		{"0.0.3", "0.0.4", false},
+		{"18446744073709551615.0.0", "18446744073709551615.1.0", true},
+		{"18446744073709551615.2.0", "18446744073709551615.1.0", false},
+		{"0.18446744073709551615.0", "0.18446744073709551615.9", true},
+	}
+
+	for _, tc := range tests {
+		compatible, err := Compatible(tc.consumer, tc.provider)
+		if err != nil {
+			t.Fatalf("error for %s and %s: %s", tc.consumer, tc.provider, err)
+		}
+		if compatible != tc.compatible {
+			t.Errorf("expected %s compatible with %s to be %t but got %t", tc.provider, tc.consumer, tc.compatible, compatible)
+		}
+	}
+
+	if _, err := Compatible("1.2", "1.5.0"); err != ErrInvalidNumberParts {
+		t.Errorf("expected error %q but got %v", ErrInvalidNumberParts, err)
+	}
+}
+
+func TestSatisfyingDiff(t *testing.T) {
+	versions := []string{"0.9.0", "1.2.0", "1.5.0", "2.0.0", "2.3.1", "1.1.0", "3.0.0"}
+
+	tests := []struct {
+		from, to     string
+		gained, lost []string
+	}{
+		{"^1.2.0", ">=1.2.0 <3.0.0", []string{"2.0.0", "2.3.1"}, nil},
+		{">=1.2.0 <3.0.0", "^1.2.0", nil, []string{"2.0.0", "2.3.1"}},
+		{"^1.0.0", "^2.0.0", []string{"2.0.0", "2.3.1"}, []string{"1.1.0", "1.2.0", "1.5.0"}},
+		{"^1.0.0", "^1.0.0", nil, nil},
+	}
+
+	for _, tc := range tests {
+		gained, lost, err := SatisfyingDiff(versions, tc.from, tc.to)
+		if err != nil {
+			t.Fatalf("error for %q to %q: %s", tc.from, tc.to, err)
+		}
+		if !reflect.DeepEqual(gained, tc.gained) {
+			t.Errorf("expected %v gained from %q to %q but got %v", tc.gained, tc.from, tc.to, gained)
+		}
+		if !reflect.DeepEqual(lost, tc.lost) {
+			t.Errorf("expected %v lost from %q to %q but got %v", tc.lost, tc.from, tc.to, lost)
+		}
+	}
+
+	if _, _, err := SatisfyingDiff(versions, "^1.0", "^2.0.0"); !errors.Is(err, ErrInvalidConstraint) {
+		t.Errorf("expected error %q but got %v", ErrInvalidConstraint, err)
+	}
+	if _, _, err := SatisfyingDiff([]string{"1.2"}, "^1.0.0", "^2.0.0"); !errors.Is(err, ErrInvalidNumberParts) {
+		t.Errorf("expected error %q but got %v", ErrInvalidNumberParts, err)
+	}
+}
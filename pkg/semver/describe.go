@@ -0,0 +1,70 @@
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Names for the common pre-release labels.
+var channelNames = map[string]string{
+	"a":     "alpha",
+	"alpha": "alpha",
+	"b":     "beta",
+	"beta":  "beta",
+	"rc":    "release candidate",
+	"pre":   "preview",
+	"dev":   "development build",
+}
+
+// channel returns the name of the pre-release channel, such as release
+// candidate for rc, and the number within the channel if there is one. Both
+// rc.1 and rc1 have the number 1. An empty name is returned when the label is
+// not a known one.
+func channel(pre string) (string, string) {
+	ids := strings.Split(pre, ".")
+	label := strings.ToLower(ids[0])
+
+	var n string
+	if i := strings.IndexAny(label, num); i > 0 && containsOnly(label[i:], num) {
+		label, n = label[:i], label[i:]
+	} else if len(ids) > 1 && containsOnly(ids[1], num) {
+		n = ids[1]
+	}
+
+	name, ok := channelNames[label]
+	if !ok {
+		return "", ""
+	}
+
+	return name, n
+}
+
+// Describe validates the version and returns a sentence describing it, such as
+// "Version 1.2.3-rc.1, a pre-release (release candidate 1) of the upcoming
+// 1.2.3".
+func Describe(ver string) (string, error) {
+	v, _, err := parse(ver)
+	if err != nil {
+		return "", err
+	}
+
+	var s string
+	if v.pre == "" {
+		s = fmt.Sprintf("Version %s, a stable release", ver)
+	} else {
+		release := &Version{major: v.major, minor: v.minor, patch: v.patch}
+		if name, n := channel(v.pre); name == "" {
+			s = fmt.Sprintf("Version %s, a pre-release of the upcoming %s", ver, release)
+		} else if n == "" {
+			s = fmt.Sprintf("Version %s, a pre-release (%s) of the upcoming %s", ver, name, release)
+		} else {
+			s = fmt.Sprintf("Version %s, a pre-release (%s %s) of the upcoming %s", ver, name, n, release)
+		}
+	}
+
+	if v.metadata != "" {
+		s += fmt.Sprintf(" with build metadata %q", v.metadata)
+	}
+
+	return s, nil
+}
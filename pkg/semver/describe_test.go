@@ -0,0 +1,33 @@
+package semver
+
+import "testing"
+
+func TestDescribe(t *testing.T) {
+	tests := []struct {
+		version     string
+		description string
+	}{
+		{"1.2.3", "Version 1.2.3, a stable release"},
+		{"1.2.3-rc.1", "Version 1.2.3-rc.1, a pre-release (release candidate 1) of the upcoming 1.2.3"},
+		{"1.2.3-RC2", "Version 1.2.3-RC2, a pre-release (release candidate 2) of the upcoming 1.2.3"},
+		{"2.0.0-beta", "Version 2.0.0-beta, a pre-release (beta) of the upcoming 2.0.0"},
+		{"2.0.0-alpha.3.x", "Version 2.0.0-alpha.3.x, a pre-release (alpha 3) of the upcoming 2.0.0"},
+		{"2.0.0-nightly.20210322", "Version 2.0.0-nightly.20210322, a pre-release of the upcoming 2.0.0"},
+		{"1.2.3+build.5", `Version 1.2.3+build.5, a stable release with build metadata "build.5"`},
+		{"1.2.3-rc.1+build.5", `Version 1.2.3-rc.1+build.5, a pre-release (release candidate 1) of the upcoming 1.2.3 with build metadata "build.5"`},
+	}
+
+	for _, tc := range tests {
+		d, err := Describe(tc.version)
+		if err != nil {
+			t.Fatalf("error for version %s: %s", tc.version, err)
+		}
+		if d != tc.description {
+			t.Errorf("expected %q but got %q", tc.description, d)
+		}
+	}
+
+	if _, err := Describe("1.2"); err != ErrInvalidNumberParts {
+		t.Errorf("expected error %q but got %v", ErrInvalidNumberParts, err)
+	}
+}
@@ -0,0 +1,31 @@
+package semver
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// ErrInvalidDockerTag is returned when a version cannot be used as a container
+// image tag, such as when it is longer than 128 characters.
+var ErrInvalidDockerTag = errors.New("Version is not a valid container image tag")
+
+// The tag format accepted by container registries.
+var dockerTagRegex = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_.-]{0,127}$`)
+
+// ValidateDockerTag validates the version and returns a form of it that can be
+// used as a container image tag. Registries do not allow a + in a tag so the +
+// before build metadata is replaced with an _ (e.g. 1.2.3+build becomes
+// 1.2.3_build).
+func ValidateDockerTag(ver string) (string, error) {
+	if _, _, err := parse(ver); err != nil {
+		return "", err
+	}
+
+	tag := strings.Replace(ver, "+", "_", 1)
+	if !dockerTagRegex.MatchString(tag) {
+		return "", ErrInvalidDockerTag
+	}
+
+	return tag, nil
+}
@@ -0,0 +1,30 @@
+package semver
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateDockerTag(t *testing.T) {
+	tests := []struct {
+		version string
+		tag     string
+		err     error
+	}{
+		{"1.2.3", "1.2.3", nil},
+		{"1.2.3+build", "1.2.3_build", nil},
+		{"1.2.3-rc.1+build.5", "1.2.3-rc.1_build.5", nil},
+		{"1.2", "", ErrInvalidNumberParts},
+		{"1.2.3-" + strings.Repeat("a", 130), "", ErrInvalidDockerTag},
+	}
+
+	for _, tc := range tests {
+		tag, err := ValidateDockerTag(tc.version)
+		if err != tc.err {
+			t.Fatalf("expected error %v for %s but got %v", tc.err, tc.version, err)
+		}
+		if tag != tc.tag {
+			t.Errorf("expected tag %q for %s but got %q", tc.tag, tc.version, tag)
+		}
+	}
+}
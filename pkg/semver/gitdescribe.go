@@ -0,0 +1,92 @@
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidGitDescribe is returned when the suffix added by git describe
+// cannot be parsed.
+var ErrInvalidGitDescribe = errors.New("Invalid git describe suffix")
+
+// The suffix git describe adds when commits exist after the tag. It is the
+// number of commits since the tag followed by a g and the abbreviated commit
+// hash. The optional -dirty is added when using --dirty.
+var gitDescribeRegex = regexp.MustCompile(`^(.+)-([0-9]+)-g([0-9a-f]{4,40})(-dirty)?$`)
+
+// The suffix git describe --dirty adds when the working tree has modifications.
+const dirtySuffix = "-dirty"
+
+// GitDescribe contains the details of a version produced by git describe.
+type GitDescribe struct {
+	// Version is the version from the tag with any leading v removed
+	Version string
+
+	// CommitsAhead is the number of commits since the tag
+	CommitsAhead uint64
+
+	// Hash is the abbreviated hash of the commit
+	Hash string
+
+	// Dirty is true when the working tree had modifications
+	Dirty bool
+}
+
+// Development returns true when the version describes a build that is not
+// exactly the tagged release. Output from git describe --long on a tag (e.g.
+// v1.2.3-0-gabcdef) has a hash but is not a development build.
+func (g *GitDescribe) Development() bool {
+	return g.CommitsAhead > 0 || g.Dirty
+}
+
+// ParseGitDescribe accepts the output of git describe (e.g. v1.2.3-14-gabcdef)
+// and returns the parsed details, a slice of messages about the version, and
+// an error if the version is not valid. The leading v is removed and the base
+// version is validated as a semantic version. A trailing -dirty, as added by
+// --dirty on the tagged commit (e.g. v1.2.3-dirty), is not treated as a
+// pre-release.
+func ParseGitDescribe(ver string) (*GitDescribe, []string, error) {
+	ver = strings.TrimPrefix(ver, "v")
+	g := &GitDescribe{Version: ver}
+
+	var messages []string
+	if m := gitDescribeRegex.FindStringSubmatch(ver); m != nil {
+		var err error
+		g.CommitsAhead, err = strconv.ParseUint(m[2], 10, 64)
+		if err != nil {
+			messages = append(messages, fmt.Sprintf("Unable to parse number of commits %q", m[2]))
+			return nil, messages, ErrInvalidGitDescribe
+		}
+		g.Version = m[1]
+		g.Hash = m[3]
+		g.Dirty = m[4] != ""
+	} else if strings.HasSuffix(ver, dirtySuffix) {
+		// On the tagged commit git describe --dirty only adds -dirty
+		g.Version = strings.TrimSuffix(ver, dirtySuffix)
+		g.Dirty = true
+	}
+
+	err, msgs := Validate(g.Version)
+	messages = append(messages, msgs...)
+	if err != nil {
+		return nil, messages, err
+	}
+
+	if g.CommitsAhead > 0 {
+		messages = append(messages, fmt.Sprintf("Found %d commits since the tagged version %q", g.CommitsAhead, g.Version))
+	}
+	if g.Hash != "" {
+		messages = append(messages, fmt.Sprintf("Found commit hash of %q", g.Hash))
+	}
+	if g.Dirty {
+		messages = append(messages, fmt.Sprint("Found modifications in the working tree"))
+	}
+	if g.Development() {
+		messages = append(messages, fmt.Sprint("NOTICE: The git describe suffix is treated like pre-release information. The version is a development build and not the tagged release."))
+	}
+
+	return g, messages, nil
+}
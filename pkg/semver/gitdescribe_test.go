@@ -0,0 +1,56 @@
+package semver
+
+import "testing"
+
+func TestParseGitDescribe(t *testing.T) {
+	tests := []struct {
+		version string
+		base    string
+		commits uint64
+		hash    string
+		dirty   bool
+		dev     bool
+		err     bool
+	}{
+		{"v1.2.3", "1.2.3", 0, "", false, false, false},
+		{"1.2.3", "1.2.3", 0, "", false, false, false},
+		{"v1.2.3-14-gabcdef", "1.2.3", 14, "abcdef", false, true, false},
+		{"v1.2.3-beta.1-2-g1a2b3c4d", "1.2.3-beta.1", 2, "1a2b3c4d", false, true, false},
+		{"v1.2.3-14-gabcdef-dirty", "1.2.3", 14, "abcdef", true, true, false},
+		{"v1.2.3-0-gabcdef", "1.2.3", 0, "abcdef", false, false, false},
+		{"v1.2.3-0-gabcdef-dirty", "1.2.3", 0, "abcdef", true, true, false},
+		{"v1.2.3-dirty", "1.2.3", 0, "", true, true, false},
+		{"v1.2.3-rc.1-dirty", "1.2.3-rc.1", 0, "", true, true, false},
+		{"v1.2-dirty", "", 0, "", false, false, true},
+		{"v1.2-14-gabcdef", "", 0, "", false, false, true},
+		{"v1.02.3-14-gabcdef", "", 0, "", false, false, true},
+	}
+
+	for _, tc := range tests {
+		g, _, err := ParseGitDescribe(tc.version)
+		if tc.err {
+			if err == nil {
+				t.Fatalf("expected error for version: %s", tc.version)
+			}
+			continue
+		} else if err != nil {
+			t.Fatalf("error for version %s: %s", tc.version, err)
+		}
+
+		if g.Version != tc.base {
+			t.Errorf("expected base version %q for %s but got %q", tc.base, tc.version, g.Version)
+		}
+		if g.CommitsAhead != tc.commits {
+			t.Errorf("expected %d commits for %s but got %d", tc.commits, tc.version, g.CommitsAhead)
+		}
+		if g.Hash != tc.hash {
+			t.Errorf("expected hash %q for %s but got %q", tc.hash, tc.version, g.Hash)
+		}
+		if g.Dirty != tc.dirty {
+			t.Errorf("expected dirty %t for %s but got %t", tc.dirty, tc.version, g.Dirty)
+		}
+		if g.Development() != tc.dev {
+			t.Errorf("expected development %t for %s but got %t", tc.dev, tc.version, g.Development())
+		}
+	}
+}
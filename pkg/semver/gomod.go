@@ -0,0 +1,60 @@
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrGoModuleMissingV is returned when a Go module version does not start
+	// with a v.
+	ErrGoModuleMissingV = errors.New("Go module version does not start with v")
+
+	// ErrGoModuleMetadata is returned when a Go module version has build
+	// metadata other than +incompatible.
+	ErrGoModuleMetadata = errors.New("Go module version has build metadata")
+
+	// ErrGoModuleIncompatible is returned when +incompatible is used on a Go
+	// module version before v2.
+	ErrGoModuleIncompatible = errors.New("Go module version before v2 marked incompatible")
+)
+
+// IsValidGoModuleVersion validates the version using the rules Go modules
+// have on top of Semantic Versioning. It returns true if the version is valid
+// along with a slice of messages about the version, in the same style as
+// Validate, and an error if the version is not valid.
+//
+// Go module versions must start with a v. Build metadata is not allowed except
+// for +incompatible, which marks a v2 or higher version of a module that does
+// not have a go.mod file. Modules at v2 or higher need the major version at the
+// end of the module path (e.g. /v2) which is noted in the messages.
+func IsValidGoModuleVersion(ver string) (bool, []string, error) {
+	if !strings.HasPrefix(ver, "v") {
+		return false, []string{"Go module versions must start with a \"v\" (e.g. v1.2.3)"}, ErrGoModuleMissingV
+	}
+
+	v, messages, err := parse(strings.TrimPrefix(ver, "v"))
+	if err != nil {
+		return false, messages, err
+	}
+
+	incompatible := false
+	if v.metadata == "incompatible" {
+		if v.major < 2 {
+			messages = append(messages, fmt.Sprintf("Go module versions can only be marked \"+incompatible\" for v2 or higher but found v%d", v.major))
+			return false, messages, ErrGoModuleIncompatible
+		}
+		incompatible = true
+		messages = append(messages, fmt.Sprint("NOTICE: The \"+incompatible\" build metadata marks a v2 or higher version of a module that does not have a go.mod file."))
+	} else if v.metadata != "" {
+		messages = append(messages, fmt.Sprintf("Go module versions cannot have build metadata but found %q", v.metadata))
+		return false, messages, ErrGoModuleMetadata
+	}
+
+	if v.major >= 2 && !incompatible {
+		messages = append(messages, fmt.Sprintf("NOTICE: Go modules at v2 or higher must have a module path ending in the major version of \"/v%d\".", v.major))
+	}
+
+	return true, messages, nil
+}
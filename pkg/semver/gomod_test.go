@@ -0,0 +1,39 @@
+package semver
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsValidGoModuleVersion(t *testing.T) {
+	tests := []struct {
+		version string
+		valid   bool
+		err     error
+		message string
+	}{
+		{"v1.2.3", true, nil, ""},
+		{"v0.1.0-beta.1", true, nil, ""},
+		{"v2.0.0", true, nil, `module path ending in the major version of "/v2"`},
+		{"v3.1.0-rc.1", true, nil, `"/v3"`},
+		{"v2.0.0+incompatible", true, nil, `"+incompatible" build metadata`},
+		{"1.2.3", false, ErrGoModuleMissingV, `must start with a "v"`},
+		{"v1.2.3+incompatible", false, ErrGoModuleIncompatible, "v2 or higher but found v1"},
+		{"v1.2.3+build.5", false, ErrGoModuleMetadata, `found "build.5"`},
+		{"v1.2", false, ErrInvalidNumberParts, ""},
+	}
+
+	for _, tc := range tests {
+		valid, msgs, err := IsValidGoModuleVersion(tc.version)
+		if err != tc.err {
+			t.Fatalf("expected error %v for %s but got %v", tc.err, tc.version, err)
+		}
+		if valid != tc.valid {
+			t.Errorf("expected valid %t for %s but got %t", tc.valid, tc.version, valid)
+		}
+
+		if tc.message != "" && !strings.Contains(strings.Join(msgs, "\n"), tc.message) {
+			t.Errorf("expected a message containing %q for %s but got %q", tc.message, tc.version, msgs)
+		}
+	}
+}
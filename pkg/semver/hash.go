@@ -0,0 +1,27 @@
+package semver
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+)
+
+// PrecedenceHash validates the version and returns a hash of the parts that
+// are used to determine precedence. These are the major, minor, patch, and
+// pre-release parts. Build metadata is excluded so two versions that differ
+// only in their metadata, and have the same precedence, have the same hash.
+func PrecedenceHash(ver string) (uint64, error) {
+	v, _, err := parse(ver)
+	if err != nil {
+		return 0, err
+	}
+
+	h := fnv.New64a()
+	var b [8]byte
+	for _, p := range []uint64{v.major, v.minor, v.patch} {
+		binary.BigEndian.PutUint64(b[:], p)
+		h.Write(b[:])
+	}
+	h.Write([]byte(v.pre))
+
+	return h.Sum64(), nil
+}
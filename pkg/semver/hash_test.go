@@ -0,0 +1,37 @@
+package semver
+
+import "testing"
+
+func TestPrecedenceHash(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		equal bool
+	}{
+		{"1.2.3+a", "1.2.3+b", true},
+		{"1.2.3", "1.2.3+build.1", true},
+		{"1.2.3-rc.1+a", "1.2.3-rc.1+b", true},
+		{"1.2.3", "1.2.4", false},
+		{"1.2.3", "1.2.3-rc.1", false},
+		{"1.2.3-rc.1", "1.2.3-rc.2", false},
+		{"1.23.0", "12.3.0", false},
+	}
+
+	for _, tc := range tests {
+		ha, err := PrecedenceHash(tc.a)
+		if err != nil {
+			t.Fatalf("error for version %s: %s", tc.a, err)
+		}
+		hb, err := PrecedenceHash(tc.b)
+		if err != nil {
+			t.Fatalf("error for version %s: %s", tc.b, err)
+		}
+
+		if (ha == hb) != tc.equal {
+			t.Errorf("expected hashes of %s and %s to be equal: %t", tc.a, tc.b, tc.equal)
+		}
+	}
+
+	if _, err := PrecedenceHash("1.2"); err != ErrInvalidNumberParts {
+		t.Errorf("expected error %q but got %v", ErrInvalidNumberParts, err)
+	}
+}
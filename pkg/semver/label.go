@@ -0,0 +1,40 @@
+package semver
+
+import "strconv"
+
+// LabelOption configures the label returned by MetricLabel.
+type LabelOption func(*labelConfig)
+
+type labelConfig struct {
+	majorOnly bool
+}
+
+// MajorOnlyLabel causes MetricLabel to return a label with only the major
+// version (e.g. 1.x).
+func MajorOnlyLabel() LabelOption {
+	return func(c *labelConfig) {
+		c.majorOnly = true
+	}
+}
+
+// MetricLabel validates the version and returns a reduced form of it suitable
+// for use as a label in metrics systems where the number of distinct labels
+// should be kept small. By default the label is the major and minor version
+// (e.g. 1.2). The patch, pre-release, and build metadata are always dropped.
+func MetricLabel(ver string, opts ...LabelOption) (string, error) {
+	cfg := &labelConfig{}
+	for _, o := range opts {
+		o(cfg)
+	}
+
+	v, _, err := parse(ver)
+	if err != nil {
+		return "", err
+	}
+
+	if cfg.majorOnly {
+		return strconv.FormatUint(v.major, 10) + ".x", nil
+	}
+
+	return strconv.FormatUint(v.major, 10) + "." + strconv.FormatUint(v.minor, 10), nil
+}
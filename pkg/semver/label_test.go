@@ -0,0 +1,36 @@
+package semver
+
+import "testing"
+
+func TestMetricLabel(t *testing.T) {
+	tests := []struct {
+		version   string
+		majorOnly bool
+		label     string
+	}{
+		{"1.2.3", false, "1.2"},
+		{"1.2.3-rc.1+build", false, "1.2"},
+		{"0.10.0", false, "0.10"},
+		{"1.2.3", true, "1.x"},
+		{"12.0.0-beta.1", true, "12.x"},
+	}
+
+	for _, tc := range tests {
+		var opts []LabelOption
+		if tc.majorOnly {
+			opts = append(opts, MajorOnlyLabel())
+		}
+
+		label, err := MetricLabel(tc.version, opts...)
+		if err != nil {
+			t.Fatalf("error for version %s: %s", tc.version, err)
+		}
+		if label != tc.label {
+			t.Errorf("expected label %q for %s but got %q", tc.label, tc.version, label)
+		}
+	}
+
+	if _, err := MetricLabel("1.2"); err != ErrInvalidNumberParts {
+		t.Errorf("expected error %q but got %v", ErrInvalidNumberParts, err)
+	}
+}
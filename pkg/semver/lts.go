@@ -0,0 +1,52 @@
+package semver
+
+// LTSPolicy describes which release lines of a project are designated as long
+// term support (LTS). A version is LTS eligible when any of the rules match.
+type LTSPolicy struct {
+	// EvenMinors designates every release line with an even minor version
+	// (e.g. 1.2 and 1.4) as LTS
+	EvenMinors bool
+
+	// Majors is a list of major versions where every release is LTS
+	Majors []uint64
+
+	// Lines is a list of major.minor release lines that are LTS
+	Lines []LTSLine
+}
+
+// LTSLine is a major.minor release line such as 1.2.
+type LTSLine struct {
+	Major, Minor uint64
+}
+
+// IsLTSEligible validates the version and returns true if it is on a release
+// line designated as LTS by the policy. Pre-release versions are never LTS
+// eligible as they are not stable releases.
+func IsLTSEligible(ver string, policy LTSPolicy) (bool, error) {
+	v, _, err := parse(ver)
+	if err != nil {
+		return false, err
+	}
+
+	if v.pre != "" {
+		return false, nil
+	}
+
+	if policy.EvenMinors && v.minor%2 == 0 {
+		return true, nil
+	}
+
+	for _, m := range policy.Majors {
+		if v.major == m {
+			return true, nil
+		}
+	}
+
+	for _, l := range policy.Lines {
+		if v.major == l.Major && v.minor == l.Minor {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
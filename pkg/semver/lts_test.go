@@ -0,0 +1,42 @@
+package semver
+
+import "testing"
+
+func TestIsLTSEligible(t *testing.T) {
+	evenMinors := LTSPolicy{EvenMinors: true}
+	explicit := LTSPolicy{
+		Majors: []uint64{3},
+		Lines:  []LTSLine{{1, 3}, {2, 7}},
+	}
+
+	tests := []struct {
+		version  string
+		policy   LTSPolicy
+		eligible bool
+		err      bool
+	}{
+		{"1.2.0", evenMinors, true, false},
+		{"1.3.0", evenMinors, false, false},
+		{"1.0.5+build", evenMinors, true, false},
+		{"1.2.0-rc.1", evenMinors, false, false},
+		{"1.3.4", explicit, true, false},
+		{"2.7.0", explicit, true, false},
+		{"2.6.0", explicit, false, false},
+		{"3.9.1", explicit, true, false},
+		{"1.2.0", LTSPolicy{}, false, false},
+		{"1.2", evenMinors, false, true},
+	}
+
+	for _, tc := range tests {
+		eligible, err := IsLTSEligible(tc.version, tc.policy)
+		if tc.err && err == nil {
+			t.Fatalf("expected error for version: %s", tc.version)
+		} else if !tc.err && err != nil {
+			t.Fatalf("error for version %s: %s", tc.version, err)
+		}
+
+		if eligible != tc.eligible {
+			t.Errorf("expected eligibility of %s to be %t but got %t", tc.version, tc.eligible, eligible)
+		}
+	}
+}
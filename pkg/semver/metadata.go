@@ -0,0 +1,45 @@
+package semver
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// WithDateMetadata validates the version and returns it with build metadata
+// of the date and time in UTC, in the form YYYYMMDD.HHMMSS, replacing any
+// existing metadata. For example, 1.2.3 becomes 1.2.3+20210322.154500.
+func WithDateMetadata(ver string, t time.Time) (string, error) {
+	v, _, err := parse(ver)
+	if err != nil {
+		return "", err
+	}
+
+	v.metadata = t.UTC().Format("20060102.150405")
+
+	// Make sure the generated metadata is valid
+	s := v.String()
+	if _, _, err := parse(s); err != nil {
+		return "", err
+	}
+
+	return s, nil
+}
+
+// SortMetadata validates the version and returns it with the identifiers in
+// the build metadata sorted in ASCII order (e.g. 1.2.3+z.a.m becomes
+// 1.2.3+a.m.z). Build metadata does not affect precedence, so this does not
+// change it, but it does change the metadata string so two builds with the
+// same metadata in a different order have the same version string.
+func SortMetadata(ver string) (string, error) {
+	v, _, err := parse(ver)
+	if err != nil {
+		return "", err
+	}
+
+	ids := strings.Split(v.metadata, ".")
+	sort.Strings(ids)
+	v.metadata = strings.Join(ids, ".")
+
+	return v.String(), nil
+}
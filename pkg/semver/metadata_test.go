@@ -0,0 +1,62 @@
+package semver
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWithDateMetadata(t *testing.T) {
+	tm := time.Date(2021, time.March, 22, 15, 4, 5, 0, time.FixedZone("EST", -5*60*60))
+
+	tests := []struct {
+		version  string
+		expected string
+	}{
+		{"1.2.3", "1.2.3+20210322.200405"},
+		{"1.2.3-rc.1", "1.2.3-rc.1+20210322.200405"},
+		{"1.2.3+build.5", "1.2.3+20210322.200405"},
+	}
+
+	for _, tc := range tests {
+		ver, err := WithDateMetadata(tc.version, tm)
+		if err != nil {
+			t.Fatalf("error for version %s: %s", tc.version, err)
+		}
+		if ver != tc.expected {
+			t.Errorf("expected %s but got %s", tc.expected, ver)
+		}
+		if err, _ := Validate(ver); err != nil {
+			t.Errorf("generated version %s is not valid: %s", ver, err)
+		}
+	}
+
+	if _, err := WithDateMetadata("1.2", tm); err != ErrInvalidNumberParts {
+		t.Errorf("expected error %q but got %v", ErrInvalidNumberParts, err)
+	}
+}
+
+func TestSortMetadata(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected string
+	}{
+		{"1.2.3+z.a.m", "1.2.3+a.m.z"},
+		{"1.2.3-rc.2.a+b.a", "1.2.3-rc.2.a+a.b"},
+		{"1.2.3+B.a.10.9", "1.2.3+10.9.B.a"},
+		{"1.2.3", "1.2.3"},
+	}
+
+	for _, tc := range tests {
+		ver, err := SortMetadata(tc.version)
+		if err != nil {
+			t.Fatalf("error for version %s: %s", tc.version, err)
+		}
+		if ver != tc.expected {
+			t.Errorf("expected %s but got %s", tc.expected, ver)
+		}
+	}
+
+	if _, err := SortMetadata("1.2"); err != ErrInvalidNumberParts {
+		t.Errorf("expected error %q but got %v", ErrInvalidNumberParts, err)
+	}
+}
@@ -0,0 +1,107 @@
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+var (
+	// ErrInvalidBitWidths is returned when the bit widths used to pack a
+	// version are not each positive or do not fit within 64 bits.
+	ErrInvalidBitWidths = errors.New("Invalid bit widths for packing a version")
+
+	// ErrPackOverflow is returned when a part of a version is too large to fit
+	// within the bits given for it.
+	ErrPackOverflow = errors.New("Version part does not fit in bit width")
+
+	// ErrPackDiscards is returned, when packing strictly, for a version with a
+	// pre-release or build metadata that would be lost when packed.
+	ErrPackDiscards = errors.New("Version has pre-release or metadata that cannot be packed")
+)
+
+// PackOption configures how PackInt packs a version.
+type PackOption func(*packConfig)
+
+type packConfig struct {
+	strict bool
+}
+
+// StrictPack causes PackInt to return ErrPackDiscards rather than ignoring a
+// pre-release or build metadata on the version.
+func StrictPack() PackOption {
+	return func(c *packConfig) {
+		c.strict = true
+	}
+}
+
+// PackInt packs the major, minor, and patch parts of a version into a single
+// integer using the bit widths given for each part (e.g. [3]int{16, 16, 16}).
+// Major is stored in the highest bits so that packed versions sort in the same
+// order as the versions. Pre-release and build metadata are ignored unless the
+// StrictPack option is used.
+func PackInt(ver string, bits [3]int, opts ...PackOption) (uint64, error) {
+	if err := checkBitWidths(bits); err != nil {
+		return 0, err
+	}
+
+	cfg := &packConfig{}
+	for _, o := range opts {
+		o(cfg)
+	}
+
+	v, _, err := parse(ver)
+	if err != nil {
+		return 0, err
+	}
+
+	if cfg.strict && (v.pre != "" || v.metadata != "") {
+		return 0, ErrPackDiscards
+	}
+
+	var packed uint64
+	for i, p := range []uint64{v.major, v.minor, v.patch} {
+		if bits[i] < 64 && p >= 1<<uint(bits[i]) {
+			return 0, fmt.Errorf("%w: %s part %d needs more than %d bits", ErrPackOverflow, numToName(i), p, bits[i])
+		}
+		packed = packed<<uint(bits[i]) | p
+	}
+
+	return packed, nil
+}
+
+// UnpackInt reverses PackInt and returns the version packed into the integer
+// using the same bit widths.
+func UnpackInt(packed uint64, bits [3]int) (string, error) {
+	if err := checkBitWidths(bits); err != nil {
+		return "", err
+	}
+
+	total := bits[0] + bits[1] + bits[2]
+	if total < 64 && packed >= 1<<uint(total) {
+		return "", fmt.Errorf("%w: %d needs more than %d bits", ErrPackOverflow, packed, total)
+	}
+
+	var parts [3]uint64
+	for i := 2; i >= 0; i-- {
+		parts[i] = packed & (1<<uint(bits[i]) - 1)
+		packed >>= uint(bits[i])
+	}
+
+	return strconv.FormatUint(parts[0], 10) + "." + strconv.FormatUint(parts[1], 10) + "." + strconv.FormatUint(parts[2], 10), nil
+}
+
+func checkBitWidths(bits [3]int) error {
+	total := 0
+	for _, b := range bits {
+		if b <= 0 {
+			return ErrInvalidBitWidths
+		}
+		total += b
+	}
+	if total > 64 {
+		return ErrInvalidBitWidths
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package semver
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPackInt(t *testing.T) {
+	tests := []struct {
+		version string
+		bits    [3]int
+		packed  uint64
+		base    string
+		err     error
+	}{
+		{"1.2.3", [3]int{16, 16, 16}, 1<<32 | 2<<16 | 3, "1.2.3", nil},
+		{"0.0.0", [3]int{16, 16, 16}, 0, "0.0.0", nil},
+		{"1.2.3-beta.1+build", [3]int{16, 16, 16}, 1<<32 | 2<<16 | 3, "1.2.3", nil},
+		{"255.255.255", [3]int{8, 8, 8}, 1<<24 - 1, "255.255.255", nil},
+		{"1.65536.3", [3]int{16, 16, 16}, 0, "", ErrPackOverflow},
+		{"256.0.0", [3]int{8, 8, 8}, 0, "", ErrPackOverflow},
+		{"1.2.3", [3]int{32, 32, 8}, 0, "", ErrInvalidBitWidths},
+		{"1.2.3", [3]int{16, 0, 16}, 0, "", ErrInvalidBitWidths},
+		{"1.2", [3]int{16, 16, 16}, 0, "", ErrInvalidNumberParts},
+	}
+
+	for _, tc := range tests {
+		packed, err := PackInt(tc.version, tc.bits)
+		if tc.err != nil {
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("expected error %q for version %s but got %v", tc.err, tc.version, err)
+			}
+			continue
+		} else if err != nil {
+			t.Fatalf("error for version %s: %s", tc.version, err)
+		}
+
+		if packed != tc.packed {
+			t.Errorf("expected %s to pack to %d but got %d", tc.version, tc.packed, packed)
+		}
+
+		ver, err := UnpackInt(packed, tc.bits)
+		if err != nil {
+			t.Fatalf("error unpacking %d: %s", packed, err)
+		}
+		if ver != tc.base {
+			t.Errorf("expected %d to unpack to %s but got %s", packed, tc.base, ver)
+		}
+	}
+}
+
+func TestPackIntStrict(t *testing.T) {
+	if _, err := PackInt("1.2.3", [3]int{16, 16, 16}, StrictPack()); err != nil {
+		t.Errorf("unexpected error packing strictly: %s", err)
+	}
+
+	if _, err := PackInt("1.2.3-beta.1", [3]int{16, 16, 16}, StrictPack()); err != ErrPackDiscards {
+		t.Errorf("expected error %q but got %v", ErrPackDiscards, err)
+	}
+}
+
+func TestUnpackIntOverflow(t *testing.T) {
+	if _, err := UnpackInt(1<<24, [3]int{8, 8, 8}); !errors.Is(err, ErrPackOverflow) {
+		t.Errorf("expected error %q but got %v", ErrPackOverflow, err)
+	}
+}
@@ -0,0 +1,40 @@
+package semver
+
+// ParityOption configures the names returned by MinorParity.
+type ParityOption func(*parityConfig)
+
+type parityConfig struct {
+	even, odd string
+}
+
+// ParityNames sets the names MinorParity returns for even and odd minor
+// versions.
+func ParityNames(even, odd string) ParityOption {
+	return func(c *parityConfig) {
+		c.even = even
+		c.odd = odd
+	}
+}
+
+// MinorParity validates the version and returns "stable" when the minor
+// version is even and "development" when it is odd. This follows the
+// convention some projects, such as older Linux kernels, use where odd minor
+// versions are for development. It is a convention and not part of Semantic
+// Versioning. The names can be changed using the ParityNames option.
+func MinorParity(ver string, opts ...ParityOption) (string, error) {
+	cfg := &parityConfig{even: "stable", odd: "development"}
+	for _, o := range opts {
+		o(cfg)
+	}
+
+	v, _, err := parse(ver)
+	if err != nil {
+		return "", err
+	}
+
+	if v.minor%2 == 0 {
+		return cfg.even, nil
+	}
+
+	return cfg.odd, nil
+}
@@ -0,0 +1,32 @@
+package semver
+
+import "testing"
+
+func TestMinorParity(t *testing.T) {
+	tests := []struct {
+		version string
+		opts    []ParityOption
+		parity  string
+	}{
+		{"1.2.0", nil, "stable"},
+		{"1.3.0", nil, "development"},
+		{"1.0.5-rc.1", nil, "stable"},
+		{"2.11.0", nil, "development"},
+		{"1.2.0", []ParityOption{ParityNames("release", "preview")}, "release"},
+		{"1.3.0", []ParityOption{ParityNames("release", "preview")}, "preview"},
+	}
+
+	for _, tc := range tests {
+		parity, err := MinorParity(tc.version, tc.opts...)
+		if err != nil {
+			t.Fatalf("error for version %s: %s", tc.version, err)
+		}
+		if parity != tc.parity {
+			t.Errorf("expected %q for %s but got %q", tc.parity, tc.version, parity)
+		}
+	}
+
+	if _, err := MinorParity("1.3"); err != ErrInvalidNumberParts {
+		t.Errorf("expected error %q but got %v", ErrInvalidNumberParts, err)
+	}
+}
@@ -0,0 +1,68 @@
+package semver
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	// ErrInvalidPathSeparator is returned when the separator used in place of
+	// a + is not safe to use in a path or could make the path the same as the
+	// one for a different version.
+	ErrInvalidPathSeparator = errors.New("Path separator is not safe for paths or does not contain an _")
+
+	// ErrInvalidPath is returned when a version cannot be made safe to use in
+	// a path.
+	ErrInvalidPath = errors.New("Version is not safe to use in a path")
+)
+
+// The characters safe to use in file and directory names on common
+// filesystems.
+const pathSafe = allowed + "._"
+
+// PathOption configures how PathSafe creates a path from a version.
+type PathOption func(*pathConfig)
+
+type pathConfig struct {
+	sep string
+}
+
+// PathSeparator sets the separator used in place of the + before build
+// metadata. It may only contain the characters [0-9A-Za-z._-] and must contain
+// an _. Versions never contain an _ so this keeps the path for one version
+// from matching another, such as 1.2.3+build with a separator of - becoming
+// the pre-release 1.2.3-build.
+func PathSeparator(sep string) PathOption {
+	return func(c *pathConfig) {
+		c.sep = sep
+	}
+}
+
+// PathSafe validates the version and returns a form of it that is safe to use
+// as a file or directory name. The characters in a version are safe on common
+// filesystems other than the + before build metadata, which is replaced with
+// an _ (e.g. 1.2.3+build becomes 1.2.3_build) or the separator set using the
+// PathSeparator option. Pre-release hyphens are kept.
+func PathSafe(ver string, opts ...PathOption) (string, error) {
+	cfg := &pathConfig{sep: "_"}
+	for _, o := range opts {
+		o(cfg)
+	}
+
+	if !containsOnly(cfg.sep, pathSafe) || !strings.Contains(cfg.sep, "_") {
+		return "", ErrInvalidPathSeparator
+	}
+
+	if _, _, err := parse(ver); err != nil {
+		return "", err
+	}
+
+	p := strings.Replace(ver, "+", cfg.sep, 1)
+
+	// Windows does not allow names ending in a period
+	if !containsOnly(p, pathSafe) || strings.HasSuffix(p, ".") {
+		return "", ErrInvalidPath
+	}
+
+	return p, nil
+}
@@ -0,0 +1,36 @@
+package semver
+
+import "testing"
+
+func TestPathSafe(t *testing.T) {
+	tests := []struct {
+		version string
+		opts    []PathOption
+		path    string
+		err     error
+	}{
+		{"1.2.3", nil, "1.2.3", nil},
+		{"1.2.3+build", nil, "1.2.3_build", nil},
+		{"1.2.3-rc-1.beta+build.5", nil, "1.2.3-rc-1.beta_build.5", nil},
+		{"1.2.3+build", []PathOption{PathSeparator("__")}, "1.2.3__build", nil},
+		{"1.2.3+build", []PathOption{PathSeparator("-_-")}, "1.2.3-_-build", nil},
+		{"1.2.3+build", []PathOption{PathSeparator("")}, "", ErrInvalidPathSeparator},
+		{"1.2.3+build", []PathOption{PathSeparator("-")}, "", ErrInvalidPathSeparator},
+		{"1.2.3+build", []PathOption{PathSeparator(".")}, "", ErrInvalidPathSeparator},
+		{"1.2.3+build", []PathOption{PathSeparator("--")}, "", ErrInvalidPathSeparator},
+		{"1.2.3-rc+1", []PathOption{PathSeparator("x")}, "", ErrInvalidPathSeparator},
+		{"1.2.3+build", []PathOption{PathSeparator("/")}, "", ErrInvalidPathSeparator},
+		{"1.2.3+build", []PathOption{PathSeparator(":")}, "", ErrInvalidPathSeparator},
+		{"1.2", nil, "", ErrInvalidNumberParts},
+	}
+
+	for _, tc := range tests {
+		p, err := PathSafe(tc.version, tc.opts...)
+		if err != tc.err {
+			t.Fatalf("expected error %v for %s but got %v", tc.err, tc.version, err)
+		}
+		if p != tc.path {
+			t.Errorf("expected path %q for %s but got %q", tc.path, tc.version, p)
+		}
+	}
+}
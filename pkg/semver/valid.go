@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"unicode"
 )
 
 var (
@@ -30,10 +31,28 @@ var (
 // - An error message if the version is not a semantic version
 // - A slice of messages with details about the version
 func Validate(ver string) (error, []string) {
+	_, messages, err := parse(ver)
+	return err, messages
+}
+
+// Components validates the version and returns each of its parts. The error
+// is the same as the one returned by Validate when the version is not valid.
+func Components(ver string) (major, minor, patch uint64, pre, meta string, err error) {
+	v, _, err := parse(ver)
+	if err != nil {
+		return 0, 0, 0, "", "", err
+	}
+
+	return v.major, v.minor, v.patch, v.pre, v.metadata, nil
+}
+
+// parse validates the version and returns the parsed version along with the
+// messages about it. The version is nil when an error is returned.
+func parse(ver string) (*Version, []string, error) {
 
 	// Check if an empty string was passed in
 	if len(ver) == 0 {
-		return ErrEmptyString, []string{}
+		return nil, []string{}, ErrEmptyString
 	}
 
 	// Split the parts into [0]major, [1]minor, and [2]patch,prerelease,build
@@ -41,10 +60,10 @@ func Validate(ver string) (error, []string) {
 	parts := strings.SplitN(ver, ".", 3)
 	if len(parts) != 3 {
 		num := len(parts)
-		return ErrInvalidNumberParts, []string{fmt.Sprintf("Found %d number of parts", num)}
+		return nil, []string{fmt.Sprintf("Found %d number of parts", num)}, ErrInvalidNumberParts
 	}
 
-	v := &version{}
+	v := &Version{}
 
 	var tmp []string
 	// Trim the patch release right to left to find any metadata or prerelease
@@ -67,13 +86,13 @@ func Validate(ver string) (error, []string) {
 	// Validate each of the major, minor, patch release segments
 	for i, p := range parts {
 		if !containsOnly(p, num) {
-			messages = append(messages, fmt.Sprintf("Illegal non-numeric characters found in %q part", numToName(i)))
-			return ErrInvalidCharacters, messages
+			messages = append(messages, fmt.Sprintf("Illegal non-numeric character %s found in %q part", describeRune(firstNotIn(p, num)), numToName(i)))
+			return nil, messages, ErrInvalidCharacters
 		}
 
 		if len(p) > 1 && p[0] == '0' {
 			messages = append(messages, fmt.Sprintf("Illegal leading 0 found in %q part", numToName(i)))
-			return ErrSegmentStartsZero, messages
+			return nil, messages, ErrSegmentStartsZero
 		}
 	}
 
@@ -82,21 +101,21 @@ func Validate(ver string) (error, []string) {
 	v.major, err = strconv.ParseUint(parts[0], 10, 64)
 	if err != nil {
 		messages = append(messages, fmt.Sprint("Unable to parse major part. Must be valid numeric characters [0-9]"))
-		return err, messages
+		return nil, messages, err
 	}
 	messages = append(messages, fmt.Sprintf("Found major version of %d", v.major))
 
 	v.minor, err = strconv.ParseUint(parts[1], 10, 64)
 	if err != nil {
 		messages = append(messages, fmt.Sprint("Unable to parse minor part. Must be valid numeric characters [0-9]"))
-		return err, messages
+		return nil, messages, err
 	}
 	messages = append(messages, fmt.Sprintf("Found minor version of %d", v.minor))
 
 	v.patch, err = strconv.ParseUint(parts[2], 10, 64)
 	if err != nil {
 		messages = append(messages, fmt.Sprint("Unable to parse patch part. Must be valid numeric characters [0-9]"))
-		return err, messages
+		return nil, messages, err
 	}
 	messages = append(messages, fmt.Sprintf("Found patch version of %d", v.patch))
 
@@ -106,11 +125,11 @@ func Validate(ver string) (error, []string) {
 			if containsOnly(p, num) {
 				if len(p) > 1 && p[0] == '0' {
 					messages = append(messages, fmt.Sprintf("Illegal leading 0 found in pre-release numeric part %q", p))
-					return ErrSegmentStartsZero, messages
+					return nil, messages, ErrSegmentStartsZero
 				}
 			} else if !containsOnly(p, allowed) {
-				messages = append(messages, fmt.Sprintf("Illegal characters found in pre-release non-numeric part %q. Must be [0-9A-Za-z-]", p))
-				return ErrInvalidCharacters, messages
+				messages = append(messages, fmt.Sprintf("Illegal character %s found in pre-release non-numeric part %q. Must be [0-9A-Za-z-]", describeRune(firstNotIn(p, allowed)), p))
+				return nil, messages, ErrInvalidCharacters
 			}
 		}
 		messages = append(messages, fmt.Sprintf("Version is a pre-release version rather than a stable release version with a pre-release identifier of %q", v.pre))
@@ -121,26 +140,35 @@ func Validate(ver string) (error, []string) {
 		tmp = strings.Split(v.metadata, ".")
 		for _, p := range tmp {
 			if !containsOnly(p, allowed) {
-				messages = append(messages, fmt.Sprintf("Illegal characters found in metadata part %q. Must be [0-9A-Za-z-]", p))
-				return ErrInvalidCharacters, messages
+				messages = append(messages, fmt.Sprintf("Illegal character %s found in metadata part %q. Must be [0-9A-Za-z-]", describeRune(firstNotIn(p, allowed)), p))
+				return nil, messages, ErrInvalidCharacters
 			}
 		}
 		messages = append(messages, fmt.Sprintf("Found build metadate on version of %q", v.metadata))
 		messages = append(messages, fmt.Sprint("NOTICE: Build metadata MUST be ignored when determining version precedence. Thus two versions that differ only in the build metadata, have the same precedence."))
 	}
 
-	return nil, messages
+	return v, messages, nil
 }
 
-const num string = "0123456789"
-const allowed string = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ-" + num
+// parseAll parses each of the versions. The error names the first version
+// that is not valid.
+func parseAll(versions []string) ([]*Version, error) {
+	vs := make([]*Version, len(versions))
+	for i, ver := range versions {
+		v, _, err := parse(ver)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid version %q: %w", ver, err)
+		}
+		vs[i] = v
+	}
 
-type version struct {
-	major, minor, patch uint64
-	pre                 string
-	metadata            string
+	return vs, nil
 }
 
+const num string = "0123456789"
+const allowed string = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ-" + num
+
 func numToName(i int) string {
 	switch i {
 	case 0:
@@ -160,3 +188,46 @@ func containsOnly(s string, comp string) bool {
 		return !strings.ContainsRune(comp, r)
 	}) == -1
 }
+
+// firstNotIn returns the first rune in s that is not in comp. It should only
+// be called when containsOnly has returned false.
+func firstNotIn(s string, comp string) rune {
+	for _, r := range s {
+		if !strings.ContainsRune(comp, r) {
+			return r
+		}
+	}
+
+	panic("No rune found outside of comp")
+}
+
+// Whitespace and control characters are invisible when printed so they are
+// referred to by name.
+var runeNames = map[rune]string{
+	0:      "null",
+	'\a':   "bell",
+	'\b':   "backspace",
+	'\t':   "tab",
+	'\n':   "newline",
+	'\v':   "vertical tab",
+	'\f':   "form feed",
+	'\r':   "carriage return",
+	' ':    "space",
+	0x7F:   "delete",
+	0xA0:   "non-breaking space",
+	0x200B: "zero width space",
+	0xFEFF: "byte order mark",
+}
+
+// describeRune returns a name for the rune suitable for messages. Visible
+// characters are quoted while invisible ones are named.
+func describeRune(r rune) string {
+	if n, ok := runeNames[r]; ok {
+		return n
+	}
+	if unicode.IsControl(r) || unicode.IsSpace(r) || !unicode.IsPrint(r) {
+		return fmt.Sprintf("%U", r)
+	}
+
+	return fmt.Sprintf("%q", r)
+}
@@ -47,3 +47,42 @@ func TestIsValid(t *testing.T) {
 		}
 	}
 }
+
+func TestInvalidCharacterNames(t *testing.T) {
+	tests := []struct {
+		version string
+		message string
+	}{
+		{"1.2.3\t", `Illegal non-numeric character tab found in "patch" part`},
+		{"1\r.2.3", `Illegal non-numeric character carriage return found in "major" part`},
+		{"1. 2.3", `Illegal non-numeric character space found in "minor" part`},
+		{"1.2.b", `Illegal non-numeric character 'b' found in "patch" part`},
+		{"1.2.3-rc\t1", `Illegal character tab found in pre-release non-numeric part "rc\t1". Must be [0-9A-Za-z-]`},
+		{"1.2.3+build\r", `Illegal character carriage return found in metadata part "build\r". Must be [0-9A-Za-z-]`},
+	}
+
+	for _, tc := range tests {
+		err, msgs := Validate(tc.version)
+		if err != ErrInvalidCharacters {
+			t.Fatalf("expected error %q for version %q but got %v", ErrInvalidCharacters, tc.version, err)
+		}
+
+		if msgs[len(msgs)-1] != tc.message {
+			t.Errorf("expected message %q for version %q but got %q", tc.message, tc.version, msgs[len(msgs)-1])
+		}
+	}
+}
+
+func TestComponents(t *testing.T) {
+	major, minor, patch, pre, meta, err := Components("1.2.3-rc.1+build")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if major != 1 || minor != 2 || patch != 3 || pre != "rc.1" || meta != "build" {
+		t.Errorf("unexpected components %d, %d, %d, %q, %q", major, minor, patch, pre, meta)
+	}
+
+	if _, _, _, _, _, err := Components("1.2"); err != ErrInvalidNumberParts {
+		t.Errorf("expected error %q but got %v", ErrInvalidNumberParts, err)
+	}
+}
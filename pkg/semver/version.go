@@ -0,0 +1,171 @@
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrPrereleaseLabelNotAllowed is returned when the first pre-release
+// identifier is not one of the labels allowed by AllowedPrereleaseLabels.
+var ErrPrereleaseLabelNotAllowed = errors.New("Pre-release label not allowed")
+
+// Version is a parsed semantic version.
+type Version struct {
+	major, minor, patch uint64
+	pre                 string
+	metadata            string
+
+	// prefix is true when a leading v is kept on the version
+	prefix bool
+
+	// original is the version as passed to Parse when using KeepOriginal
+	original string
+}
+
+// ParseOption configures how Parse validates a version.
+type ParseOption func(*parseConfig)
+
+type parseConfig struct {
+	allowV, preserveV, keepOriginal bool
+	restrictLabels                  bool
+	labels                          []string
+}
+
+// AllowLeadingV allows a v at the start of the version, which is not part of
+// Semantic Versioning. It is removed before the version is validated.
+func AllowLeadingV() ParseOption {
+	return func(c *parseConfig) {
+		c.allowV = true
+	}
+}
+
+// PreserveVPrefix keeps a leading v, allowed using AllowLeadingV, so that it
+// is included when the Version is turned back into a string. Without it the v
+// is dropped.
+func PreserveVPrefix() ParseOption {
+	return func(c *parseConfig) {
+		c.preserveV = true
+	}
+}
+
+// KeepOriginal keeps the version exactly as it was passed to Parse so it can
+// be retrieved using Original. String still returns the canonical form.
+func KeepOriginal() ParseOption {
+	return func(c *parseConfig) {
+		c.keepOriginal = true
+	}
+}
+
+// AllowedPrereleaseLabels requires the first identifier of a pre-release to be
+// one of the labels (e.g. alpha, beta, and rc so 1.2.3-rc.1 is allowed while
+// 1.2.3-nightly is not). The labels are matched exactly, including case. By
+// default any valid pre-release is allowed while passing no labels allows none.
+func AllowedPrereleaseLabels(labels ...string) ParseOption {
+	return func(c *parseConfig) {
+		c.restrictLabels = true
+		c.labels = labels
+	}
+}
+
+// Parse validates the version and returns it parsed. The error is the same as
+// the one returned by Validate when the version is not valid.
+func Parse(ver string, opts ...ParseOption) (*Version, error) {
+	cfg := &parseConfig{}
+	for _, o := range opts {
+		o(cfg)
+	}
+
+	orig := ver
+	prefix := false
+	if cfg.allowV && strings.HasPrefix(ver, "v") {
+		ver = strings.TrimPrefix(ver, "v")
+		prefix = cfg.preserveV
+	}
+
+	v, _, err := parse(ver)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.pre != "" && cfg.restrictLabels {
+		label := strings.SplitN(v.pre, ".", 2)[0]
+		if len(cfg.labels) == 0 {
+			return nil, fmt.Errorf("%w: %q as no labels are allowed", ErrPrereleaseLabelNotAllowed, label)
+		}
+		if !containsString(cfg.labels, label) {
+			return nil, fmt.Errorf("%w: %q is not one of %s", ErrPrereleaseLabelNotAllowed, label, strings.Join(cfg.labels, ", "))
+		}
+	}
+
+	v.prefix = prefix
+	if cfg.keepOriginal {
+		v.original = orig
+	}
+
+	return v, nil
+}
+
+// Major returns the major version.
+func (v *Version) Major() uint64 {
+	return v.major
+}
+
+// Minor returns the minor version.
+func (v *Version) Minor() uint64 {
+	return v.minor
+}
+
+// Patch returns the patch version.
+func (v *Version) Patch() uint64 {
+	return v.patch
+}
+
+// Prerelease returns the pre-release or an empty string if there is none.
+func (v *Version) Prerelease() string {
+	return v.pre
+}
+
+// Metadata returns the build metadata or an empty string if there is none.
+func (v *Version) Metadata() string {
+	return v.metadata
+}
+
+// HasVPrefix returns true when the version had a leading v that was kept
+// using PreserveVPrefix.
+func (v *Version) HasVPrefix() bool {
+	return v.prefix
+}
+
+// Original returns the version exactly as it was passed to Parse. It is only
+// kept when using the KeepOriginal option and is empty otherwise.
+func (v *Version) Original() string {
+	return v.original
+}
+
+// String returns the version in its canonical form.
+func (v *Version) String() string {
+	s := strconv.FormatUint(v.major, 10) + "." + strconv.FormatUint(v.minor, 10) + "." + strconv.FormatUint(v.patch, 10)
+	if v.prefix {
+		s = "v" + s
+	}
+	if v.pre != "" {
+		s += "-" + v.pre
+	}
+	if v.metadata != "" {
+		s += "+" + v.metadata
+	}
+
+	return s
+}
+
+func containsString(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+
+	return false
+}
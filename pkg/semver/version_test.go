@@ -0,0 +1,118 @@
+package semver
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		version string
+		opts    []ParseOption
+		str     string
+		prefix  bool
+		err     error
+	}{
+		{"1.2.3", nil, "1.2.3", false, nil},
+		{"1.2.3-rc.1+build", nil, "1.2.3-rc.1+build", false, nil},
+		{"v1.2.3", nil, "", false, ErrInvalidCharacters},
+		{"v1.2.3", []ParseOption{AllowLeadingV()}, "1.2.3", false, nil},
+		{"v1.2.3", []ParseOption{AllowLeadingV(), PreserveVPrefix()}, "v1.2.3", true, nil},
+		{"v1.2.3-beta.1+b", []ParseOption{AllowLeadingV(), PreserveVPrefix()}, "v1.2.3-beta.1+b", true, nil},
+		{"1.2.3", []ParseOption{AllowLeadingV(), PreserveVPrefix()}, "1.2.3", false, nil},
+		{"v1.2.3", []ParseOption{PreserveVPrefix()}, "", false, ErrInvalidCharacters},
+		{"v1.2", []ParseOption{AllowLeadingV()}, "", false, ErrInvalidNumberParts},
+	}
+
+	for _, tc := range tests {
+		v, err := Parse(tc.version, tc.opts...)
+		if err != tc.err {
+			t.Fatalf("expected error %v for %s but got %v", tc.err, tc.version, err)
+		}
+		if err != nil {
+			continue
+		}
+
+		if v.String() != tc.str {
+			t.Errorf("expected %q for %s but got %q", tc.str, tc.version, v.String())
+		}
+		if v.HasVPrefix() != tc.prefix {
+			t.Errorf("expected prefix %t for %s but got %t", tc.prefix, tc.version, v.HasVPrefix())
+		}
+	}
+}
+
+func TestVersionAccessors(t *testing.T) {
+	v, err := Parse("1.2.3-rc.1+build")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v.Major() != 1 || v.Minor() != 2 || v.Patch() != 3 || v.Prerelease() != "rc.1" || v.Metadata() != "build" {
+		t.Errorf("unexpected parts for %s", v)
+	}
+}
+
+func TestParseKeepOriginal(t *testing.T) {
+	v, err := Parse("v1.2.3-rc.1+build", AllowLeadingV(), KeepOriginal())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v.Original() != "v1.2.3-rc.1+build" {
+		t.Errorf("expected original %q but got %q", "v1.2.3-rc.1+build", v.Original())
+	}
+	if v.String() != "1.2.3-rc.1+build" {
+		t.Errorf("expected canonical form %q but got %q", "1.2.3-rc.1+build", v.String())
+	}
+
+	v, err = Parse("v1.2.3", AllowLeadingV())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Original() != "" {
+		t.Errorf("expected no original without KeepOriginal but got %q", v.Original())
+	}
+}
+
+func TestParseAllowedPrereleaseLabels(t *testing.T) {
+	allowed := AllowedPrereleaseLabels("alpha", "beta", "rc")
+
+	tests := []struct {
+		version string
+		err     error
+	}{
+		{"1.2.3-rc.1", nil},
+		{"1.2.3-alpha", nil},
+		{"1.2.3-beta.2+build", nil},
+		{"1.2.3", nil},
+		{"1.2.3+build", nil},
+		{"1.2.3-nightly", ErrPrereleaseLabelNotAllowed},
+		{"1.2.3-RC.1", ErrPrereleaseLabelNotAllowed},
+		{"1.2.3-rc1", ErrPrereleaseLabelNotAllowed},
+		{"1.2.3-1.rc", ErrPrereleaseLabelNotAllowed},
+		{"1.2-rc", ErrInvalidNumberParts},
+	}
+
+	for _, tc := range tests {
+		_, err := Parse(tc.version, allowed)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("expected error %v for %s but got %v", tc.err, tc.version, err)
+		}
+	}
+
+	if _, err := Parse("1.2.3-nightly", allowed); err == nil || err.Error() != `Pre-release label not allowed: "nightly" is not one of alpha, beta, rc` {
+		t.Errorf("unexpected error message: %v", err)
+	}
+
+	if _, err := Parse("1.2.3-nightly"); err != nil {
+		t.Errorf("expected any pre-release label by default but got %s", err)
+	}
+
+	if _, err := Parse("1.2.3-rc.1", AllowedPrereleaseLabels()); !errors.Is(err, ErrPrereleaseLabelNotAllowed) {
+		t.Errorf("expected error %v with no allowed labels but got %v", ErrPrereleaseLabelNotAllowed, err)
+	}
+	if _, err := Parse("1.2.3+build", AllowedPrereleaseLabels()); err != nil {
+		t.Errorf("expected a release with no allowed labels to be valid but got %s", err)
+	}
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/mattfarina/semver-isvalid/pkg/semver"
+	"github.com/spf13/cobra"
+)
+
+func newSatisfiesCmd() *cobra.Command {
+	var explain bool
+
+	cmd := &cobra.Command{
+		Use:   "satisfies [version] [constraint]",
+		Short: "check if a semantic version satisfies a constraint",
+		Long:  satisfiesdesc,
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			if disableColor {
+				color.NoColor = true
+			}
+
+			os.Exit(satisfies(os.Stdout, os.Stderr, args[0], args[1], explain))
+		},
+	}
+
+	cmd.Flags().BoolVar(&explain, "explain", false, "display the result of each comparison in the constraint")
+
+	return cmd
+}
+
+const satisfiesdesc = `check if a semantic version satisfies a constraint
+
+The constraint is made up of comparisons, such as >=1.2.0, separated by a
+space or comma that must all be satisfied. Groups of comparisons can be
+separated by || where any one group must be satisfied. The operators are =,
+!=, >, >=, <, <=, ^ (same major version), and ~ (same minor version). Any
+pre-release of a version, such as release candidates, can be matched using -*
+after it (e.g. 1.2.3-*).
+
+For example:
+
+    $ semver-isvalid satisfies 1.3.0 ">=1.2.0 <1.4.0 || >=2.0.0"
+    1.3.0 satisfies ">=1.2.0 <1.4.0 || >=2.0.0"
+
+To see why a version does or does not satisfy a constraint use the --explain
+flag to display the result of each comparison. For example:
+
+    $ semver-isvalid satisfies 1.5.0 ">=1.2.0 <1.4.0 || >=2.0.0" --explain
+    Group 1 failed:
+      >=1.2.0 passed
+      <1.4.0 failed
+    Group 2 failed:
+      >=2.0.0 failed
+    1.5.0 does not satisfy ">=1.2.0 <1.4.0 || >=2.0.0"
+
+When the version does not satisfy the constraint the exit code is 10. An
+invalid constraint has an exit code of 11.
+`
+
+// satisfies checks the version against the constraint and returns the exit
+// code.
+func satisfies(out, errOut io.Writer, ver, constraint string, explain bool) int {
+	msgs, err := check(ver)
+	if err != nil {
+		red.Fprintf(errOut, "%s\n", invalidMessage(err))
+		return exitCode(err)
+	}
+
+	// Only the notices are displayed as the details of the version are not
+	// what is being checked
+	for _, m := range msgs {
+		if strings.HasPrefix(m, "NOTICE:") {
+			fmt.Fprintln(out, m)
+		}
+	}
+
+	ver = stripV(ver)
+
+	cs, err := semver.NewConstraint(constraint)
+	if err != nil {
+		red.Fprintf(errOut, "%s\n", err)
+		return 11
+	}
+
+	e, _ := cs.Explain(ver)
+	if explain {
+		for i, g := range e.Groups {
+			fmt.Fprintf(out, "Group %d %s:\n", i+1, passed(g.Satisfied))
+			for _, c := range g.Comparisons {
+				fmt.Fprintf(out, "  %s %s\n", c.Comparison, passed(c.Satisfied))
+			}
+			if g.PrereleaseExcluded {
+				fmt.Fprintf(out, "  pre-release %s is not allowed as no comparison has a pre-release of the same version\n", ver)
+			}
+		}
+	}
+
+	if !e.Satisfied {
+		red.Fprintf(out, "%s does not satisfy %q\n", ver, constraint)
+		return 10
+	}
+
+	fmt.Fprintf(out, "%s satisfies %q\n", ver, constraint)
+	return 0
+}
+
+func passed(b bool) string {
+	if b {
+		return "passed"
+	}
+
+	return "failed"
+}
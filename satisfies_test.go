@@ -0,0 +1,86 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestSatisfiesExplain(t *testing.T) {
+	var out, errOut bytes.Buffer
+	code := satisfies(&out, &errOut, "1.5.0", ">=1.2.0 <1.4.0 || >=2.0.0", true)
+	if code != 10 {
+		t.Errorf("expected exit code 10 but got %d", code)
+	}
+
+	expected := `Group 1 failed:
+  >=1.2.0 passed
+  <1.4.0 failed
+Group 2 failed:
+  >=2.0.0 failed
+1.5.0 does not satisfy ">=1.2.0 <1.4.0 || >=2.0.0"
+`
+	if out.String() != expected {
+		t.Errorf("expected output:\n%s\nbut got:\n%s", expected, out.String())
+	}
+}
+
+func TestSatisfies(t *testing.T) {
+	tests := []struct {
+		version, constraint string
+		code                int
+	}{
+		{"1.3.0", ">=1.2.0 <1.4.0 || >=2.0.0", 0},
+		{"2.1.0", ">=1.2.0 <1.4.0 || >=2.0.0", 0},
+		{"1.5.0", ">=1.2.0 <1.4.0 || >=2.0.0", 10},
+		{"1.5", "^1.0.0", 4},
+		{"1.5.0", "^1.0", 11},
+	}
+
+	for _, tc := range tests {
+		var out, errOut bytes.Buffer
+		if code := satisfies(&out, &errOut, tc.version, tc.constraint, false); code != tc.code {
+			t.Errorf("expected exit code %d for %s and %q but got %d", tc.code, tc.version, tc.constraint, code)
+		}
+	}
+}
+
+func TestSatisfiesVersionFlags(t *testing.T) {
+	tests := []struct {
+		version            string
+		warnV, gitDescribe bool
+		code               int
+		message            string
+	}{
+		{"v1.3.0", true, false, 0, `NOTICE: The leading "v" is not part of Semantic Versioning.`},
+		{"v1.3.0-14-gabcdef", false, true, 10, "NOTICE: The git describe suffix is treated like pre-release information."},
+		{"v1.3-14-gabcdef", false, true, 4, "Invalid Semantic Version"},
+	}
+
+	defer func() { warnV, gitDescribe = false, false }()
+	for _, tc := range tests {
+		warnV, gitDescribe = tc.warnV, tc.gitDescribe
+
+		var out, errOut bytes.Buffer
+		if code := satisfies(&out, &errOut, tc.version, "^1.2.0", false); code != tc.code {
+			t.Errorf("expected exit code %d for %s but got %d", tc.code, tc.version, code)
+		}
+
+		if o := out.String() + errOut.String(); !strings.Contains(o, tc.message) {
+			t.Errorf("expected %q in output for %s:\n%s", tc.message, tc.version, o)
+		}
+	}
+}
+
+func TestSatisfiesRootFlags(t *testing.T) {
+	for _, f := range []string{"--dir=.", "--file=-", "--input=json", "--output=shields", "--version-file-glob=*"} {
+		cmd := newRootCmd()
+		cmd.SetArgs([]string{"satisfies", "1.2.3", "^1.0.0", f})
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetErr(&bytes.Buffer{})
+
+		if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "unknown flag") {
+			t.Errorf("expected unknown flag error for %s but got %v", f, err)
+		}
+	}
+}
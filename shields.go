@@ -0,0 +1,39 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// shieldsEndpoint is the JSON used by shields.io endpoint badges. See
+// https://shields.io/endpoint for details.
+type shieldsEndpoint struct {
+	SchemaVersion int    `json:"schemaVersion"`
+	Label         string `json:"label"`
+	Message       string `json:"message"`
+	Color         string `json:"color"`
+}
+
+// validateShields validates the version and writes the result in the shields.io
+// endpoint badge format. The exit code is the same as when validating without
+// the badge.
+func validateShields(out io.Writer, ver string) int {
+	_, err := check(ver)
+
+	s := shieldsEndpoint{
+		SchemaVersion: 1,
+		Label:         "semver",
+		Message:       "valid",
+		Color:         "green",
+	}
+	if err != nil {
+		s.Message = "invalid"
+		s.Color = "red"
+	}
+
+	if err := json.NewEncoder(out).Encode(s); err != nil {
+		return 2
+	}
+
+	return exitCode(err)
+}
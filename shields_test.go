@@ -0,0 +1,27 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestValidateShields(t *testing.T) {
+	tests := []struct {
+		version string
+		code    int
+		json    string
+	}{
+		{"1.2.3", 0, `{"schemaVersion":1,"label":"semver","message":"valid","color":"green"}`},
+		{"1.2", 4, `{"schemaVersion":1,"label":"semver","message":"invalid","color":"red"}`},
+	}
+
+	for _, tc := range tests {
+		var out bytes.Buffer
+		if code := validateShields(&out, tc.version); code != tc.code {
+			t.Errorf("expected exit code %d for %s but got %d", tc.code, tc.version, code)
+		}
+		if out.String() != tc.json+"\n" {
+			t.Errorf("expected %s for %s but got %s", tc.json, tc.version, out.String())
+		}
+	}
+}